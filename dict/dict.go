@@ -0,0 +1,223 @@
+// Package dict provides generic helpers for plain Go maps.
+//
+// Go randomizes map iteration order. Helpers that return slices say whether their order
+// is sorted or unspecified, and Sorted / SortedFunc give deterministic iteration.
+package dict
+
+import (
+	"cmp"
+	"iter"
+	"slices"
+)
+
+// Entry is a key-value pair of a map, as returned by ToEntries.
+type Entry[K comparable, V any] struct {
+	Key   K
+	Value V
+}
+
+// Keys returns the keys of the map 'm' in unspecified order.
+func Keys[M ~map[K]V, K comparable, V any](m M) []K {
+	result := make([]K, 0, len(m))
+	for k := range m {
+		result = append(result, k)
+	}
+	return result
+}
+
+// SortedKeys returns the keys of the map 'm' in ascending order.
+func SortedKeys[M ~map[K]V, K cmp.Ordered, V any](m M) []K {
+	result := Keys(m)
+	slices.Sort(result)
+	return result
+}
+
+// Values returns the values of the map 'm' in unspecified order.
+func Values[M ~map[K]V, K comparable, V any](m M) []V {
+	result := make([]V, 0, len(m))
+	for _, v := range m {
+		result = append(result, v)
+	}
+	return result
+}
+
+// SortedValues returns the values of the map 'm' in ascending order.
+func SortedValues[M ~map[K]V, K comparable, V cmp.Ordered](m M) []V {
+	result := Values(m)
+	slices.Sort(result)
+	return result
+}
+
+// Sorted returns an iterator over the entries of the map 'm' in ascending key order.
+//
+//	for k, v := range dict.Sorted(m) { ... }
+func Sorted[M ~map[K]V, K cmp.Ordered, V any](m M) iter.Seq2[K, V] {
+	return SortedFunc(m, cmp.Compare[K])
+}
+
+// SortedFunc returns an iterator over the entries of the map 'm' with keys ordered by
+// 'compare'. Keys are sorted when iteration starts; a value deleted from the map before it
+// is reached is skipped.
+func SortedFunc[M ~map[K]V, K comparable, V any](m M, compare func(a, b K) int) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		keys := Keys(m)
+		slices.SortFunc(keys, compare)
+		for _, k := range keys {
+			v, ok := m[k]
+			if ok && !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// MapValues returns a new map with the same keys and 'f' applied to each value.
+func MapValues[M ~map[K]V, K comparable, V any, W any](m M, f func(V) W) map[K]W {
+	result := make(map[K]W, len(m))
+	for k, v := range m {
+		result[k] = f(v)
+	}
+	return result
+}
+
+// MapKeys returns a new map with 'f' applied to each key. If 'f' maps two keys to the same
+// new key, which value is kept is unspecified.
+func MapKeys[M ~map[K]V, K comparable, V any, K2 comparable](m M, f func(K) K2) map[K2]V {
+	result := make(map[K2]V, len(m))
+	for k, v := range m {
+		result[f(k)] = v
+	}
+	return result
+}
+
+// FilterMap returns a new map with the entries of 'm' for which 'f' returns true.
+func FilterMap[M ~map[K]V, K comparable, V any](m M, f func(K, V) bool) M {
+	result := make(M)
+	for k, v := range m {
+		if f(k, v) {
+			result[k] = v
+		}
+	}
+	return result
+}
+
+// Invert returns a map from each value of 'm' to its key. If several keys share a value,
+// which key is kept is unspecified; use InvertGroup to keep them all.
+func Invert[M ~map[K]V, K comparable, V comparable](m M) map[V]K {
+	result := make(map[V]K, len(m))
+	for k, v := range m {
+		result[v] = k
+	}
+	return result
+}
+
+// InvertGroup returns a map from each value of 'm' to all the keys that hold it, in
+// unspecified order.
+func InvertGroup[M ~map[K]V, K comparable, V comparable](m M) map[V][]K {
+	result := make(map[V][]K)
+	for k, v := range m {
+		result[v] = append(result[v], k)
+	}
+	return result
+}
+
+// Merge returns a new map with the entries of all 'maps'. When a key appears in more than
+// one map, 'resolve' is called with the key, the value merged so far and the new value, in
+// the order the maps are given, and its result is kept. A nil resolver keeps the last value.
+//
+//	totals := dict.Merge(func(_ string, a, b int) int { return a + b }, jan, feb, mar)
+func Merge[M ~map[K]V, K comparable, V any](resolve func(key K, existing, incoming V) V, maps ...M) M {
+	size := 0
+	for _, m := range maps {
+		size = max(size, len(m))
+	}
+	result := make(M, size)
+	for _, m := range maps {
+		for k, v := range m {
+			if existing, ok := result[k]; ok && resolve != nil {
+				v = resolve(k, existing, v)
+			}
+			result[k] = v
+		}
+	}
+	return result
+}
+
+// Pick returns a new map with only the given keys of 'm'. Keys missing from 'm' are ignored.
+func Pick[M ~map[K]V, K comparable, V any](m M, keys ...K) M {
+	result := make(M, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			result[k] = v
+		}
+	}
+	return result
+}
+
+// Omit returns a new map with every entry of 'm' except the given keys.
+func Omit[M ~map[K]V, K comparable, V any](m M, keys ...K) M {
+	result := make(M, len(m))
+	for k, v := range m {
+		result[k] = v
+	}
+	for _, k := range keys {
+		delete(result, k)
+	}
+	return result
+}
+
+// PickBy is FilterMap: it returns a new map with the entries for which 'f' returns true.
+func PickBy[M ~map[K]V, K comparable, V any](m M, f func(K, V) bool) M {
+	return FilterMap(m, f)
+}
+
+// OmitBy returns a new map without the entries for which 'f' returns true.
+func OmitBy[M ~map[K]V, K comparable, V any](m M, f func(K, V) bool) M {
+	return FilterMap(m, func(k K, v V) bool { return !f(k, v) })
+}
+
+// ToEntries returns the entries of the map 'm' in ascending key order.
+func ToEntries[M ~map[K]V, K cmp.Ordered, V any](m M) []Entry[K, V] {
+	result := make([]Entry[K, V], 0, len(m))
+	for k, v := range Sorted(m) {
+		result = append(result, Entry[K, V]{Key: k, Value: v})
+	}
+	return result
+}
+
+// FromEntries builds a map from 'entries'; a later entry overwrites an earlier one with
+// the same key.
+func FromEntries[K comparable, V any](entries []Entry[K, V]) map[K]V {
+	result := make(map[K]V, len(entries))
+	for _, e := range entries {
+		result[e.Key] = e.Value
+	}
+	return result
+}
+
+// CountBy counts the entries of 'm' for each group returned by 'f'.
+func CountBy[M ~map[K]V, K comparable, V any, G comparable](m M, f func(K, V) G) map[G]int {
+	result := make(map[G]int)
+	for k, v := range m {
+		result[f(k, v)]++
+	}
+	return result
+}
+
+// CountValues counts how many keys of 'm' hold each value.
+func CountValues[M ~map[K]V, K comparable, V comparable](m M) map[V]int {
+	return CountBy(m, func(_ K, v V) V { return v })
+}
+
+// GroupBy splits 'm' into sub-maps by the group returned from 'f'.
+func GroupBy[M ~map[K]V, K comparable, V any, G comparable](m M, f func(K, V) G) map[G]M {
+	result := make(map[G]M)
+	for k, v := range m {
+		g := f(k, v)
+		if result[g] == nil {
+			result[g] = make(M)
+		}
+		result[g][k] = v
+	}
+	return result
+}
@@ -0,0 +1,136 @@
+package dict_test
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/kishankumarhs/fnkit/dict"
+)
+
+var ages = map[string]int{"ann": 31, "bob": 25, "cy": 31, "di": 40}
+
+func TestKeysValues(t *testing.T) {
+	keys := dict.Keys(ages)
+	slices.Sort(keys)
+	if !reflect.DeepEqual(keys, []string{"ann", "bob", "cy", "di"}) {
+		t.Errorf("Keys = %v", keys)
+	}
+	if got := dict.SortedKeys(ages); !reflect.DeepEqual(got, []string{"ann", "bob", "cy", "di"}) {
+		t.Errorf("SortedKeys = %v", got)
+	}
+	if got := dict.SortedValues(ages); !reflect.DeepEqual(got, []int{25, 31, 31, 40}) {
+		t.Errorf("SortedValues = %v", got)
+	}
+	if got := dict.Values(map[int]string{}); len(got) != 0 {
+		t.Errorf("Values of empty = %v", got)
+	}
+}
+
+func TestSorted(t *testing.T) {
+	var keys []string
+	for k, v := range dict.Sorted(ages) {
+		keys = append(keys, k)
+		if ages[k] != v {
+			t.Errorf("Sorted yielded %s=%d, want %d", k, v, ages[k])
+		}
+	}
+	if !reflect.DeepEqual(keys, []string{"ann", "bob", "cy", "di"}) {
+		t.Errorf("Sorted order = %v", keys)
+	}
+	keys = keys[:0]
+	for k := range dict.SortedFunc(ages, func(a, b string) int { return strings.Compare(b, a) }) {
+		keys = append(keys, k)
+		if len(keys) == 2 {
+			break
+		}
+	}
+	if !reflect.DeepEqual(keys, []string{"di", "cy"}) {
+		t.Errorf("SortedFunc descending with break = %v", keys)
+	}
+}
+
+func TestTransform(t *testing.T) {
+	if got := dict.MapValues(ages, func(v int) bool { return v > 30 }); !reflect.DeepEqual(got, map[string]bool{"ann": true, "bob": false, "cy": true, "di": true}) {
+		t.Errorf("MapValues = %v", got)
+	}
+	if got := dict.MapKeys(ages, strings.ToUpper); got["ANN"] != 31 || len(got) != 4 {
+		t.Errorf("MapKeys = %v", got)
+	}
+	if got := dict.FilterMap(ages, func(k string, v int) bool { return v == 31 }); !reflect.DeepEqual(got, map[string]int{"ann": 31, "cy": 31}) {
+		t.Errorf("FilterMap = %v", got)
+	}
+	if got := dict.Invert(map[string]int{"a": 1, "b": 2}); !reflect.DeepEqual(got, map[int]string{1: "a", 2: "b"}) {
+		t.Errorf("Invert = %v", got)
+	}
+	groups := dict.InvertGroup(ages)
+	slices.Sort(groups[31])
+	if !reflect.DeepEqual(groups[31], []string{"ann", "cy"}) || len(groups) != 3 {
+		t.Errorf("InvertGroup = %v", groups)
+	}
+}
+
+func TestMerge(t *testing.T) {
+	jan := map[string]int{"a": 1, "b": 2}
+	feb := map[string]int{"b": 10, "c": 3}
+	mar := map[string]int{"b": 100}
+	sum := dict.Merge(func(_ string, a, b int) int { return a + b }, jan, feb, mar)
+	if !reflect.DeepEqual(sum, map[string]int{"a": 1, "b": 112, "c": 3}) {
+		t.Errorf("Merge(sum) = %v", sum)
+	}
+	last := dict.Merge(nil, jan, feb)
+	if !reflect.DeepEqual(last, map[string]int{"a": 1, "b": 10, "c": 3}) {
+		t.Errorf("Merge(nil) = %v", last)
+	}
+	if jan["b"] != 2 {
+		t.Error("Merge modified its input")
+	}
+	if got := dict.Merge[map[string]int](nil); len(got) != 0 {
+		t.Errorf("Merge() = %v", got)
+	}
+}
+
+func TestPickOmit(t *testing.T) {
+	type config map[string]string
+	c := config{"host": "localhost", "port": "80", "debug": "true"}
+	picked := dict.Pick(c, "host", "port", "missing")
+	if !reflect.DeepEqual(picked, config{"host": "localhost", "port": "80"}) {
+		t.Errorf("Pick = %v", picked)
+	}
+	if got := dict.Omit(c, "debug"); !reflect.DeepEqual(got, config{"host": "localhost", "port": "80"}) {
+		t.Errorf("Omit = %v", got)
+	}
+	if len(c) != 3 {
+		t.Error("Omit modified its input")
+	}
+	isNumber := func(_, v string) bool { return strings.Trim(v, "0123456789") == "" }
+	if got := dict.PickBy(c, isNumber); !reflect.DeepEqual(got, config{"port": "80"}) {
+		t.Errorf("PickBy = %v", got)
+	}
+	if got := dict.OmitBy(c, isNumber); len(got) != 2 || got["port"] != "" {
+		t.Errorf("OmitBy = %v", got)
+	}
+}
+
+func TestEntriesAndCounts(t *testing.T) {
+	entries := dict.ToEntries(map[string]int{"b": 2, "a": 1})
+	if want := []dict.Entry[string, int]{{"a", 1}, {"b", 2}}; !reflect.DeepEqual(entries, want) {
+		t.Errorf("ToEntries = %v, want %v", entries, want)
+	}
+	entries = append(entries, dict.Entry[string, int]{Key: "a", Value: 9})
+	if got := dict.FromEntries(entries); !reflect.DeepEqual(got, map[string]int{"a": 9, "b": 2}) {
+		t.Errorf("FromEntries = %v", got)
+	}
+	if got := dict.CountValues(ages); !reflect.DeepEqual(got, map[int]int{25: 1, 31: 2, 40: 1}) {
+		t.Errorf("CountValues = %v", got)
+	}
+	over30 := func(_ string, v int) bool { return v > 30 }
+	if got := dict.CountBy(ages, over30); !reflect.DeepEqual(got, map[bool]int{true: 3, false: 1}) {
+		t.Errorf("CountBy = %v", got)
+	}
+	groups := dict.GroupBy(ages, over30)
+	if !reflect.DeepEqual(groups[false], map[string]int{"bob": 25}) || len(groups[true]) != 3 {
+		t.Errorf("GroupBy = %v", groups)
+	}
+}
@@ -0,0 +1,3 @@
+module github.com/kishankumarhs/fnkit/dict
+
+go 1.23.2
@@ -32,3 +32,9 @@ func (r Result[T]) ValueOr(fallback T) T {
 	}
 	return fallback
 }
+
+// ResultOf wraps a (value, error) pair in a Result, so a call such as
+// fnkit.ResultOf(fnkit.TryMap(s, strconv.Atoi)) can be passed around as one value.
+func ResultOf[T any](val T, err error) Result[T] {
+	return Result[T]{Value: val, Err: err}
+}
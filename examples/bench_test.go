@@ -1,6 +1,7 @@
 package main
 
 import (
+	"fmt"
 	"strings"
 	"testing"
 
@@ -110,3 +111,120 @@ func BenchmarkIsEmail(b *testing.B) {
 		}
 	}
 }
+
+var commandNames = []string{
+	"status", "commit", "checkout", "branch", "merge", "rebase", "push", "pull",
+	"fetch", "clone", "init", "log", "diff", "stash", "tag", "remote", "reset", "revert",
+}
+
+func BenchmarkLevenshtein(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = fnkit.Levenshtein("kitten sitting", "sitting kitten")
+	}
+}
+
+func BenchmarkLevenshteinWithin(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_, _ = fnkit.LevenshteinWithin("kitten sitting", "sitting kitten", 2)
+	}
+}
+
+func BenchmarkDamerauLevenshtein(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = fnkit.DamerauLevenshtein("kitten sitting", "sitting kitten")
+	}
+}
+
+func BenchmarkJaroWinkler(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = fnkit.JaroWinkler("checkout", "chekcout")
+	}
+}
+
+func BenchmarkTrigramSimilarity(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = fnkit.TrigramSimilarity("checkout", "chekcout")
+	}
+}
+
+func BenchmarkClosestMatch(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = fnkit.ClosestMatch("chekcout", commandNames, 3)
+	}
+}
+
+func secretReplacements() map[string]string {
+	repl := make(map[string]string, 50)
+	for i := 0; i < 50; i++ {
+		repl[fmt.Sprintf("secret-token-%02d", i)] = "[REDACTED]"
+	}
+	return repl
+}
+
+func logLine() string {
+	return strings.Repeat("user=alice action=login token=secret-token-07 ip=10.0.0.1 ", 20)
+}
+
+func BenchmarkMatcherReplace(b *testing.B) {
+	repl := secretReplacements()
+	keys := make([]string, 0, len(repl))
+	for k := range repl {
+		keys = append(keys, k)
+	}
+	m := fnkit.NewMatcher(keys...)
+	line := logLine()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = m.Replace(line, func(o fnkit.Occurrence) string { return repl[o.Pattern] })
+	}
+}
+
+func BenchmarkReplaceMany(b *testing.B) {
+	repl := secretReplacements()
+	line := logLine()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = fnkit.ReplaceMany(line, repl)
+	}
+}
+
+func BenchmarkStdlibReplaceAllLoop(b *testing.B) {
+	repl := secretReplacements()
+	line := logLine()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		out := line
+		for k, v := range repl {
+			out = strings.ReplaceAll(out, k, v)
+		}
+	}
+}
+
+func BenchmarkMatcherContainsAny(b *testing.B) {
+	keywords := make([]string, 200)
+	for i := range keywords {
+		keywords[i] = fmt.Sprintf("keyword%03d", i)
+	}
+	m := fnkit.NewMatcher(keywords...)
+	line := logLine()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = m.ContainsAny(line)
+	}
+}
+
+func BenchmarkStdlibContainsLoop(b *testing.B) {
+	keywords := make([]string, 200)
+	for i := range keywords {
+		keywords[i] = fmt.Sprintf("keyword%03d", i)
+	}
+	line := logLine()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, k := range keywords {
+			if strings.Contains(line, k) {
+				break
+			}
+		}
+	}
+}
@@ -8,6 +8,8 @@ require (
 	github.com/kishankumarhs/fnkit/validations v0.0.0
 )
 
+require golang.org/x/text v0.22.0 // indirect
+
 replace github.com/kishankumarhs/fnkit => ../
 
 replace github.com/kishankumarhs/fnkit/concurrency => ../concurrency
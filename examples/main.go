@@ -29,12 +29,9 @@ func main() {
 	time.Sleep(200 * time.Millisecond)
 
 	// --- Real-world: String utilities for slug generation ---
-	titles := []string{"Go is Awesome!", "Hello, World!"}
+	titles := []string{"Go is Awesome!", "Hello, World!", "Crème Brûlée"}
 	slugs := fnkit.Map(titles, func(s string) string {
-		s = strings.ToLower(s)
-		s = strings.ReplaceAll(s, " ", "-")
-		s = strings.ReplaceAll(s, "!", "")
-		return s
+		return fnkit.Slugify(s, fnkit.SlugOptions{MaxLength: 40})
 	})
 	fmt.Println("Slugs:", slugs)
 
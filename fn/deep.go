@@ -2,6 +2,9 @@ package fn
 
 import (
 	"reflect"
+	"sync"
+	"time"
+	"unsafe"
 )
 
 // DeepEqual returns true if a and b are deeply equal (recursively compares all fields).
@@ -9,8 +12,180 @@ func DeepEqual(a, b any) bool {
 	return reflect.DeepEqual(a, b)
 }
 
-// DeepCopy returns a deep copy of v (for most built-in types, slices, maps, structs).
-// Note: This uses reflection and is not as fast as hand-written copy logic.
+// Cloner is implemented by types that know how to copy themselves. DeepCopy calls Clone
+// on any value whose type has a Clone method returning that same type, instead of copying
+// it field by field.
+type Cloner[T any] interface {
+	Clone() T
+}
+
+// CopyOptions configures DeepCopyWith.
+type CopyOptions struct {
+	// Unexported also deep-copies unexported struct fields. By default they are copied as
+	// in a plain assignment, so maps, slices and pointers held in them stay shared.
+	Unexported bool
+}
+
+// DeepCopy returns a deep copy of v: pointers, slices, arrays, maps, structs and interfaces
+// are copied recursively, so the copy shares no mutable memory with v through them.
+// Cycles and shared references are preserved: two pointers to the same value in v point
+// to the same (new) value in the copy. Channels, functions and unsafe pointers are shared.
+// Map keys are not copied.
+//
+// Types implementing Cloner, and types registered with RegisterCopier, are copied with
+// their own function.
+//
+// Unexported struct fields are copied shallowly unless CopyOptions.Unexported is set, so
+// types that keep their state in unexported fields, such as big.Int, bytes.Buffer or
+// strings.Builder, still share it with the original: changing the copy changes v.
 func DeepCopy[T any](v T) T {
-	return reflect.ValueOf(v).Interface().(T)
+	return DeepCopyWith(v, CopyOptions{})
+}
+
+// DeepCopyWith is DeepCopy with options.
+func DeepCopyWith[T any](v T, opts CopyOptions) T {
+	c := &copier{opts: opts, visited: make(map[visitKey]reflect.Value)}
+	out := c.copy(reflect.ValueOf(&v).Elem())
+	var result T
+	reflect.ValueOf(&result).Elem().Set(out)
+	return result
+}
+
+var copiers sync.Map // reflect.Type -> func(reflect.Value) reflect.Value
+
+func init() {
+	// A time.Time is an immutable value; copying its *Location would break comparisons
+	// against time.Local.
+	RegisterCopier(func(t time.Time) time.Time { return t })
+}
+
+// RegisterCopier registers f as the copy function DeepCopy uses for values of type T,
+// wherever they appear. Use it for types that hold resources DeepCopy must not duplicate
+// naively, or whose unexported state needs care. A later registration for the same type
+// replaces the earlier one.
+func RegisterCopier[T any](f func(T) T) {
+	copiers.Store(reflect.TypeFor[T](), func(v reflect.Value) reflect.Value {
+		var in T
+		reflect.ValueOf(&in).Elem().Set(v)
+		out := f(in)
+		return reflect.ValueOf(&out).Elem()
+	})
+}
+
+type copier struct {
+	opts    CopyOptions
+	visited map[visitKey]reflect.Value
+}
+
+// visitKey identifies memory already copied: a pointer, map or slice of a given type and length.
+type visitKey struct {
+	ptr uintptr
+	typ reflect.Type
+	len int
+}
+
+func (c *copier) copy(src reflect.Value) reflect.Value {
+	t := src.Type()
+	if f, ok := copiers.Load(t); ok {
+		return f.(func(reflect.Value) reflect.Value)(src)
+	}
+	if out, ok := c.clone(src); ok {
+		return out
+	}
+	switch src.Kind() {
+	case reflect.Pointer:
+		if src.IsNil() {
+			return reflect.Zero(t)
+		}
+		key := visitKey{ptr: src.Pointer(), typ: t}
+		if dst, ok := c.visited[key]; ok {
+			return dst
+		}
+		dst := reflect.New(t.Elem())
+		c.visited[key] = dst
+		dst.Elem().Set(c.copy(src.Elem()))
+		return dst
+	case reflect.Map:
+		if src.IsNil() {
+			return reflect.Zero(t)
+		}
+		key := visitKey{ptr: src.Pointer(), typ: t}
+		if dst, ok := c.visited[key]; ok {
+			return dst
+		}
+		dst := reflect.MakeMapWithSize(t, src.Len())
+		c.visited[key] = dst
+		iter := src.MapRange()
+		for iter.Next() {
+			dst.SetMapIndex(iter.Key(), c.copy(iter.Value()))
+		}
+		return dst
+	case reflect.Slice:
+		if src.IsNil() {
+			return reflect.Zero(t)
+		}
+		key := visitKey{ptr: src.Pointer(), typ: t, len: src.Len()}
+		if dst, ok := c.visited[key]; ok {
+			return dst
+		}
+		dst := reflect.MakeSlice(t, src.Len(), src.Cap())
+		c.visited[key] = dst
+		for i := 0; i < src.Len(); i++ {
+			dst.Index(i).Set(c.copy(src.Index(i)))
+		}
+		return dst
+	case reflect.Array:
+		dst := reflect.New(t).Elem()
+		for i := 0; i < src.Len(); i++ {
+			dst.Index(i).Set(c.copy(src.Index(i)))
+		}
+		return dst
+	case reflect.Struct:
+		dst := reflect.New(t).Elem()
+		dst.Set(src) // carries unexported fields over as in an assignment
+		for i := 0; i < t.NumField(); i++ {
+			if t.Field(i).IsExported() {
+				dst.Field(i).Set(c.copy(src.Field(i)))
+				continue
+			}
+			if !c.opts.Unexported {
+				continue
+			}
+			if !src.CanAddr() {
+				tmp := reflect.New(t).Elem()
+				tmp.Set(src)
+				src = tmp
+			}
+			exposed(dst.Field(i)).Set(c.copy(exposed(src.Field(i))))
+		}
+		return dst
+	case reflect.Interface:
+		if src.IsNil() {
+			return reflect.Zero(t)
+		}
+		dst := reflect.New(t).Elem()
+		dst.Set(c.copy(src.Elem()))
+		return dst
+	}
+	// Scalars, strings, channels, functions and unsafe pointers.
+	return src
+}
+
+// clone calls the value's Clone method if its type implements Cloner for itself.
+func (c *copier) clone(src reflect.Value) (reflect.Value, bool) {
+	t := src.Type()
+	m, ok := t.MethodByName("Clone")
+	if !ok || m.Type.NumIn() != 1 || m.Type.NumOut() != 1 || m.Type.Out(0) != t || !src.CanInterface() {
+		return reflect.Value{}, false
+	}
+	if (src.Kind() == reflect.Pointer || src.Kind() == reflect.Interface) && src.IsNil() {
+		return reflect.Zero(t), true
+	}
+	return src.Method(m.Index).Call(nil)[0], true
+}
+
+// exposed returns the addressable field v with the read-only flag reflect sets on
+// unexported fields cleared, so that it can be read and set.
+func exposed(v reflect.Value) reflect.Value {
+	return reflect.NewAt(v.Type(), unsafe.Pointer(v.UnsafeAddr())).Elem()
 }
@@ -0,0 +1,152 @@
+package fn_test
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/kishankumarhs/fnkit/fn"
+)
+
+type node struct {
+	Name     string
+	Next     *node
+	Children []*node
+	Attrs    map[string][]int
+	Any      any
+	Arr      [2][]int
+	Ch       chan int
+	hidden   []int
+}
+
+func TestDeepCopyNoAliasing(t *testing.T) {
+	ch := make(chan int)
+	src := &node{
+		Name:     "root",
+		Children: []*node{{Name: "a"}},
+		Attrs:    map[string][]int{"x": {1, 2}},
+		Any:      map[string]any{"k": []string{"v"}},
+		Arr:      [2][]int{{1}, {2}},
+		Ch:       ch,
+		hidden:   []int{9},
+	}
+	dst := fn.DeepCopy(src)
+	if !reflect.DeepEqual(src, dst) {
+		t.Fatalf("DeepCopy = %+v, want equal to %+v", dst, src)
+	}
+
+	dst.Children[0].Name = "changed"
+	dst.Attrs["x"][0] = 100
+	dst.Any.(map[string]any)["k"].([]string)[0] = "changed"
+	dst.Arr[0][0] = 100
+	if src.Children[0].Name != "a" || src.Attrs["x"][0] != 1 || src.Any.(map[string]any)["k"].([]string)[0] != "v" || src.Arr[0][0] != 1 {
+		t.Errorf("mutating the copy changed the original: %+v", src)
+	}
+	if dst.Ch != ch {
+		t.Error("channels should be shared")
+	}
+	if &dst.hidden[0] != &src.hidden[0] {
+		t.Error("unexported fields should be copied shallowly by default")
+	}
+
+	deep := fn.DeepCopyWith(src, fn.CopyOptions{Unexported: true})
+	if deep.hidden[0] != 9 || &deep.hidden[0] == &src.hidden[0] {
+		t.Errorf("CopyOptions.Unexported should copy unexported fields, got %v", deep.hidden)
+	}
+}
+
+func TestDeepCopyUnexportedState(t *testing.T) {
+	x := big.NewInt(5)
+	y := fn.DeepCopy(x)
+	y.SetInt64(7)
+	if x.Int64() != 7 {
+		t.Errorf("big.Int should share its unexported digits by default, x = %v", x)
+	}
+
+	x = big.NewInt(5)
+	y = fn.DeepCopyWith(x, fn.CopyOptions{Unexported: true})
+	y.SetInt64(7)
+	if x.Int64() != 5 {
+		t.Errorf("CopyOptions.Unexported should copy big.Int digits, x = %v", x)
+	}
+}
+
+func TestDeepCopyCycles(t *testing.T) {
+	a := &node{Name: "a"}
+	b := &node{Name: "b", Next: a}
+	a.Next = b
+	a.Children = []*node{b, b}
+
+	c := fn.DeepCopy(a)
+	if c == a || c.Next == b {
+		t.Fatal("DeepCopy returned original pointers")
+	}
+	if c.Next.Next != c {
+		t.Error("cycle was not preserved")
+	}
+	if c.Children[0] != c.Next || c.Children[1] != c.Next {
+		t.Error("shared pointers should stay shared in the copy")
+	}
+
+	m := map[string]any{}
+	m["self"] = m
+	cm := fn.DeepCopy(m)
+	if reflect.ValueOf(cm["self"]).Pointer() != reflect.ValueOf(cm).Pointer() {
+		t.Error("self-referencing map was not preserved")
+	}
+}
+
+func TestDeepCopyNilsAndScalars(t *testing.T) {
+	var p *node
+	if fn.DeepCopy(p) != nil {
+		t.Error("nil pointer should copy to nil")
+	}
+	var s []int
+	if fn.DeepCopy(s) != nil {
+		t.Error("nil slice should copy to nil")
+	}
+	var v any
+	if fn.DeepCopy(v) != nil {
+		t.Error("nil interface should copy to nil")
+	}
+	if got := fn.DeepCopy[any](42); got != 42 {
+		t.Errorf("DeepCopy(42) = %v", got)
+	}
+	now := time.Now()
+	if got := fn.DeepCopyWith(now, fn.CopyOptions{Unexported: true}); !got.Equal(now) || got.Location() != now.Location() {
+		t.Errorf("DeepCopy(time) = %v, want %v", got, now)
+	}
+}
+
+type pool struct {
+	ID    int
+	conns []int
+}
+
+func (p *pool) Clone() *pool {
+	return &pool{ID: p.ID + 1000}
+}
+
+type handle struct{ fd int }
+
+func TestDeepCopyClonerAndRegistry(t *testing.T) {
+	type holder struct {
+		P *pool
+		H *handle
+	}
+	var _ fn.Cloner[*pool] = (*pool)(nil)
+	fn.RegisterCopier(func(h *handle) *handle { return h })
+
+	src := holder{P: &pool{ID: 1}, H: &handle{fd: 3}}
+	dst := fn.DeepCopy(src)
+	if dst.P.ID != 1001 {
+		t.Errorf("Clone was not used: %+v", dst.P)
+	}
+	if dst.H != src.H {
+		t.Error("registered copier was not used")
+	}
+	if got := fn.DeepCopy(holder{}); got.P != nil {
+		t.Errorf("nil Cloner should copy to nil, got %+v", got.P)
+	}
+}
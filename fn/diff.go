@@ -0,0 +1,593 @@
+package fn
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ChangeKind says whether a Change added, removed or modified a value.
+type ChangeKind int
+
+const (
+	Modified ChangeKind = iota
+	Added
+	Removed
+)
+
+func (k ChangeKind) String() string {
+	switch k {
+	case Added:
+		return "added"
+	case Removed:
+		return "removed"
+	}
+	return "modified"
+}
+
+// Change is one difference reported by Diff. Path holds the struct field names (json tag
+// names where set), map keys and slice indexes leading from the root to the value; it is
+// empty for the root itself. Old is unset for Added changes and New is unset for Removed ones.
+type Change struct {
+	Path []string
+	Kind ChangeKind
+	Old  any
+	New  any
+}
+
+func (c Change) String() string {
+	path := strings.Join(c.Path, ".")
+	if path == "" {
+		path = "(root)"
+	}
+	switch c.Kind {
+	case Added:
+		return fmt.Sprintf("+ %s: %#v", path, c.New)
+	case Removed:
+		return fmt.Sprintf("- %s: %#v", path, c.Old)
+	}
+	return fmt.Sprintf("~ %s: %#v -> %#v", path, c.Old, c.New)
+}
+
+// DiffOptions configures DiffWith.
+//
+// Patterns in IgnoreFields and SliceKeys are dotted paths as in Change.Path, where "*"
+// matches any single segment, e.g. "Teams.*.Members". An IgnoreFields entry without a dot
+// matches a struct field of that name (Go or json name) anywhere.
+type DiffOptions struct {
+	IgnoreFields []string
+	// EquateEmpty treats nil and empty slices and maps as equal.
+	EquateEmpty bool
+	// FloatEpsilon treats floats that differ by at most this much as equal.
+	FloatEpsilon float64
+	// SliceKeys compares the slices at matching paths as unordered collections, pairing
+	// elements by the (comparable) key the function returns.
+	SliceKeys map[string]func(elem any) any
+}
+
+// Diff returns the differences between a and b, in an order that Apply can replay to turn
+// a into b. Structs are compared by their exported fields, except for types with an
+// Equal(T) bool method (like time.Time) and structs without exported fields, which are
+// compared as a whole. As with encoding/json, fields tagged `json:"-"` are skipped, and
+// the exported fields of embedded unexported structs are compared as if they belonged to
+// the outer struct; embedded pointers to unexported structs are skipped.
+//
+//	Diff(user{Name: "ada", Tags: []string{"a"}}, user{Name: "bob", Tags: []string{}})
+//	// [~ Name: "ada" -> "bob", - Tags.0: "a"]
+func Diff[T any](a, b T) []Change {
+	return DiffWith(a, b, DiffOptions{})
+}
+
+// DiffWith is Diff with options.
+func DiffWith[T any](a, b T, opts DiffOptions) []Change {
+	d := &differ{opts: opts, visited: make(map[visitPair]bool)}
+	d.diff(nil, reflect.ValueOf(&a).Elem(), reflect.ValueOf(&b).Elem())
+	return d.changes
+}
+
+type differ struct {
+	opts    DiffOptions
+	visited map[visitPair]bool
+	changes []Change
+}
+
+// visitPair identifies a pair of pointers or maps already being compared, to stop on cycles.
+type visitPair struct {
+	a, b uintptr
+	typ  reflect.Type
+}
+
+func (d *differ) add(path []string, kind ChangeKind, a, b reflect.Value) {
+	c := Change{Path: append([]string(nil), path...), Kind: kind}
+	if kind != Added {
+		c.Old = a.Interface()
+	}
+	if kind != Removed {
+		c.New = b.Interface()
+	}
+	d.changes = append(d.changes, c)
+}
+
+func (d *differ) diff(path []string, a, b reflect.Value) {
+	if a.Type() != b.Type() {
+		d.add(path, Modified, a, b)
+		return
+	}
+	t := a.Type()
+	if eq, ok := equalMethod(a, b); ok {
+		if !eq {
+			d.add(path, Modified, a, b)
+		}
+		return
+	}
+	switch a.Kind() {
+	case reflect.Interface:
+		if a.IsNil() || b.IsNil() {
+			if a.IsNil() != b.IsNil() {
+				d.add(path, Modified, a, b)
+			}
+			return
+		}
+		if a.Elem().Type() != b.Elem().Type() {
+			d.add(path, Modified, a, b)
+			return
+		}
+		d.diff(path, a.Elem(), b.Elem())
+	case reflect.Pointer:
+		if a.IsNil() || b.IsNil() {
+			if a.IsNil() != b.IsNil() {
+				d.add(path, Modified, a, b)
+			}
+			return
+		}
+		key := visitPair{a.Pointer(), b.Pointer(), t}
+		if key.a == key.b || d.visited[key] {
+			return
+		}
+		d.visited[key] = true
+		d.diff(path, a.Elem(), b.Elem())
+	case reflect.Struct:
+		if !d.diffFields(path, a, b) && !reflect.DeepEqual(a.Interface(), b.Interface()) {
+			d.add(path, Modified, a, b)
+		}
+	case reflect.Map:
+		d.diffMap(path, a, b)
+	case reflect.Slice:
+		if a.IsNil() != b.IsNil() && !(d.opts.EquateEmpty && a.Len() == 0 && b.Len() == 0) {
+			d.add(path, Modified, a, b)
+			return
+		}
+		if keyFn := d.sliceKey(path); keyFn != nil {
+			d.diffKeyed(path, a, b, keyFn)
+			return
+		}
+		d.diffSeq(path, a, b)
+	case reflect.Array:
+		d.diffSeq(path, a, b)
+	case reflect.Float32, reflect.Float64:
+		if x, y := a.Float(), b.Float(); x != y && !(math.Abs(x-y) <= d.opts.FloatEpsilon) {
+			d.add(path, Modified, a, b)
+		}
+	default:
+		if !reflect.DeepEqual(a.Interface(), b.Interface()) {
+			d.add(path, Modified, a, b)
+		}
+	}
+}
+
+// diffFields compares the exported fields of the structs a and b, descending into embedded
+// unexported structs without adding a path segment, and reports whether there were any.
+func (d *differ) diffFields(path []string, a, b reflect.Value) bool {
+	exported := false
+	t := a.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if promoted(f) {
+			exported = d.diffFields(path, a.Field(i), b.Field(i)) || exported
+			continue
+		}
+		if !f.IsExported() {
+			continue
+		}
+		exported = true
+		if f.Tag.Get("json") == "-" {
+			continue
+		}
+		name := jsonName(f)
+		fpath := append(path, name)
+		if d.ignored(f.Name, name, fpath) {
+			continue
+		}
+		d.diff(fpath, a.Field(i), b.Field(i))
+	}
+	return exported
+}
+
+// promoted reports whether f is an embedded unexported struct whose exported fields
+// encoding/json promotes into the outer object.
+func promoted(f reflect.StructField) bool {
+	return f.Anonymous && !f.IsExported() && f.Type.Kind() == reflect.Struct && f.Tag.Get("json") == ""
+}
+
+func (d *differ) diffMap(path []string, a, b reflect.Value) {
+	if a.IsNil() != b.IsNil() && !(d.opts.EquateEmpty && a.Len() == 0 && b.Len() == 0) {
+		d.add(path, Modified, a, b)
+		return
+	}
+	if a.IsNil() && b.IsNil() {
+		return
+	}
+	key := visitPair{a.Pointer(), b.Pointer(), a.Type()}
+	if key.a == key.b || d.visited[key] {
+		return
+	}
+	d.visited[key] = true
+
+	keys := append(a.MapKeys(), b.MapKeys()...)
+	sort.Slice(keys, func(i, j int) bool { return fmt.Sprint(keys[i]) < fmt.Sprint(keys[j]) })
+	seen := make(map[any]bool, len(keys))
+	for _, k := range keys {
+		if seen[k.Interface()] {
+			continue
+		}
+		seen[k.Interface()] = true
+		kpath := append(path, fmt.Sprint(k))
+		av, bv := a.MapIndex(k), b.MapIndex(k)
+		switch {
+		case !bv.IsValid():
+			d.add(kpath, Removed, av, bv)
+		case !av.IsValid():
+			d.add(kpath, Added, av, bv)
+		default:
+			d.diff(kpath, av, bv)
+		}
+	}
+}
+
+// diffSeq compares slices and arrays position by position. Surplus elements are reported
+// as additions in ascending order, or removals in descending order, so that each change
+// is valid after the previous one has been applied.
+func (d *differ) diffSeq(path []string, a, b reflect.Value) {
+	n := min(a.Len(), b.Len())
+	for i := 0; i < n; i++ {
+		d.diff(append(path, strconv.Itoa(i)), a.Index(i), b.Index(i))
+	}
+	for i := n; i < b.Len(); i++ {
+		d.add(append(path, strconv.Itoa(i)), Added, reflect.Value{}, b.Index(i))
+	}
+	for i := a.Len() - 1; i >= n; i-- {
+		d.add(append(path, strconv.Itoa(i)), Removed, a.Index(i), reflect.Value{})
+	}
+}
+
+// diffKeyed compares slices as unordered collections keyed by keyFn. Paired elements are
+// compared at their index in a; unpaired ones in a are removed from the highest index
+// down, and unpaired ones in b are appended.
+func (d *differ) diffKeyed(path []string, a, b reflect.Value, keyFn func(any) any) {
+	inA := make(map[any]int, a.Len())
+	for i := 0; i < a.Len(); i++ {
+		inA[keyFn(a.Index(i).Interface())] = i
+	}
+	paired := make(map[int]bool, a.Len())
+	var added []int
+	for j := 0; j < b.Len(); j++ {
+		i, ok := inA[keyFn(b.Index(j).Interface())]
+		if !ok || paired[i] {
+			added = append(added, j)
+			continue
+		}
+		paired[i] = true
+		d.diff(append(path, strconv.Itoa(i)), a.Index(i), b.Index(j))
+	}
+	for i := a.Len() - 1; i >= 0; i-- {
+		if !paired[i] {
+			d.add(append(path, strconv.Itoa(i)), Removed, a.Index(i), reflect.Value{})
+		}
+	}
+	n := len(paired)
+	for _, j := range added {
+		d.add(append(path, strconv.Itoa(n)), Added, reflect.Value{}, b.Index(j))
+		n++
+	}
+}
+
+func (d *differ) ignored(goName, name string, path []string) bool {
+	for _, p := range d.opts.IgnoreFields {
+		if !strings.Contains(p, ".") && (p == goName || p == name) || matchPath(p, path) {
+			return true
+		}
+	}
+	return false
+}
+
+func (d *differ) sliceKey(path []string) func(any) any {
+	for p, f := range d.opts.SliceKeys {
+		if matchPath(p, path) {
+			return f
+		}
+	}
+	return nil
+}
+
+// matchPath reports whether path matches the dotted pattern, where "*" matches any segment.
+func matchPath(pattern string, path []string) bool {
+	segs := strings.Split(pattern, ".")
+	if len(segs) != len(path) {
+		return false
+	}
+	for i, s := range segs {
+		if s != "*" && s != path[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// equalMethod calls a.Equal(b) if a's type has an Equal method taking its own type and
+// returning bool.
+func equalMethod(a, b reflect.Value) (eq, ok bool) {
+	t := a.Type()
+	if t.Kind() == reflect.Interface {
+		return false, false
+	}
+	m, ok := t.MethodByName("Equal")
+	if !ok || m.Type.NumIn() != 2 || m.Type.In(1) != t || m.Type.NumOut() != 1 || m.Type.Out(0).Kind() != reflect.Bool {
+		return false, false
+	}
+	if t.Kind() == reflect.Pointer && (a.IsNil() || b.IsNil()) {
+		return a.IsNil() && b.IsNil(), true
+	}
+	return a.Method(m.Index).Call([]reflect.Value{b})[0].Bool(), true
+}
+
+// jsonName returns the json tag name of f if it has one, otherwise its Go name.
+func jsonName(f reflect.StructField) string {
+	if tag, _, _ := strings.Cut(f.Tag.Get("json"), ","); tag != "" && tag != "-" {
+		return tag
+	}
+	return f.Name
+}
+
+// ErrApply is returned by Apply for changes whose path does not exist in the target or
+// whose value does not fit it.
+var ErrApply = errors.New("fn: cannot apply change")
+
+// Apply replays changes, as returned by Diff, on the value v points to: Apply(&a, Diff(a, b))
+// turns a into a value equal to b. New values are deep-copied into v. Apply stops at the
+// first change that cannot be applied and returns an error wrapping ErrApply; the changes
+// before it remain applied. Keys of maps with interface key types are applied as strings.
+func Apply[T any](v *T, changes []Change) error {
+	root := reflect.ValueOf(v).Elem()
+	for _, c := range changes {
+		if err := apply(root, c.Path, c); err != nil {
+			return fmt.Errorf("%w %s: %v", ErrApply, strings.Join(c.Path, "."), err)
+		}
+	}
+	return nil
+}
+
+// apply applies c at path below the settable value v.
+func apply(v reflect.Value, path []string, c Change) error {
+	if len(path) == 0 {
+		if c.Kind == Removed {
+			v.Set(reflect.Zero(v.Type()))
+			return nil
+		}
+		nv, err := valueFor(c.New, v.Type())
+		if err != nil {
+			return err
+		}
+		v.Set(nv)
+		return nil
+	}
+	seg, rest := path[0], path[1:]
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			if c.Kind == Removed {
+				return errors.New("nil pointer")
+			}
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		return apply(v.Elem(), path, c)
+	case reflect.Interface:
+		if v.IsNil() {
+			return errors.New("nil interface")
+		}
+		inner := reflect.New(v.Elem().Type()).Elem()
+		inner.Set(v.Elem())
+		if err := apply(inner, path, c); err != nil {
+			return err
+		}
+		v.Set(inner)
+		return nil
+	case reflect.Struct:
+		if f, ok := fieldByName(v, seg); ok {
+			return apply(f, rest, c)
+		}
+		return fmt.Errorf("no field %q in %s", seg, v.Type())
+	case reflect.Map:
+		key, err := parseKey(seg, v.Type().Key())
+		if err != nil {
+			return err
+		}
+		if len(rest) == 0 {
+			return applyMapEntry(v, key, c)
+		}
+		cur := v.MapIndex(key)
+		if !cur.IsValid() {
+			return fmt.Errorf("no key %q", seg)
+		}
+		elem := reflect.New(cur.Type()).Elem()
+		elem.Set(cur)
+		if err := apply(elem, rest, c); err != nil {
+			return err
+		}
+		v.SetMapIndex(key, elem)
+		return nil
+	case reflect.Slice, reflect.Array:
+		i, err := strconv.Atoi(seg)
+		if err != nil || i < 0 {
+			return fmt.Errorf("bad index %q", seg)
+		}
+		if len(rest) == 0 && c.Kind != Modified {
+			if v.Kind() == reflect.Array {
+				return errors.New("cannot add to or remove from an array")
+			}
+			return applySliceElem(v, i, c)
+		}
+		if i >= v.Len() {
+			return fmt.Errorf("index %d out of range", i)
+		}
+		return apply(v.Index(i), rest, c)
+	}
+	return fmt.Errorf("cannot descend into %s", v.Type())
+}
+
+// fieldByName returns the field of the struct v that Diff reports under name.
+func fieldByName(v reflect.Value, name string) (reflect.Value, bool) {
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Type().Field(i)
+		if promoted(f) {
+			if fv, ok := fieldByName(v.Field(i), name); ok {
+				return fv, true
+			}
+			continue
+		}
+		if f.IsExported() && f.Tag.Get("json") != "-" && jsonName(f) == name {
+			return v.Field(i), true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+func applyMapEntry(m, key reflect.Value, c Change) error {
+	if c.Kind == Removed {
+		if !m.MapIndex(key).IsValid() {
+			return errors.New("no such key")
+		}
+		m.SetMapIndex(key, reflect.Value{})
+		return nil
+	}
+	nv, err := valueFor(c.New, m.Type().Elem())
+	if err != nil {
+		return err
+	}
+	if m.IsNil() {
+		m.Set(reflect.MakeMap(m.Type()))
+	}
+	m.SetMapIndex(key, nv)
+	return nil
+}
+
+// applySliceElem inserts or removes element i of the slice s, building a new backing
+// array so that other slices sharing the old one are unaffected.
+func applySliceElem(s reflect.Value, i int, c Change) error {
+	n := s.Len()
+	if c.Kind == Removed {
+		if i >= n {
+			return fmt.Errorf("index %d out of range", i)
+		}
+		out := reflect.MakeSlice(s.Type(), 0, n-1)
+		out = reflect.AppendSlice(out, s.Slice(0, i))
+		s.Set(reflect.AppendSlice(out, s.Slice(i+1, n)))
+		return nil
+	}
+	if i > n {
+		return fmt.Errorf("index %d out of range", i)
+	}
+	nv, err := valueFor(c.New, s.Type().Elem())
+	if err != nil {
+		return err
+	}
+	out := reflect.MakeSlice(s.Type(), 0, n+1)
+	out = reflect.AppendSlice(out, s.Slice(0, i))
+	out = reflect.Append(out, nv)
+	s.Set(reflect.AppendSlice(out, s.Slice(i, n)))
+	return nil
+}
+
+// valueFor returns a deep copy of x as a value of type t.
+func valueFor(x any, t reflect.Type) (reflect.Value, error) {
+	if x == nil {
+		return reflect.Zero(t), nil
+	}
+	v := reflect.ValueOf(DeepCopy(x))
+	if !v.Type().AssignableTo(t) {
+		return reflect.Value{}, fmt.Errorf("%s is not assignable to %s", v.Type(), t)
+	}
+	return v, nil
+}
+
+// parseKey converts a path segment back into a map key of type t.
+func parseKey(seg string, t reflect.Type) (reflect.Value, error) {
+	var v reflect.Value
+	switch t.Kind() {
+	case reflect.String:
+		v = reflect.ValueOf(seg)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(seg, 10, t.Bits())
+		if err != nil {
+			return v, fmt.Errorf("bad key %q for %s", seg, t)
+		}
+		v = reflect.ValueOf(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(seg, 10, t.Bits())
+		if err != nil {
+			return v, fmt.Errorf("bad key %q for %s", seg, t)
+		}
+		v = reflect.ValueOf(n)
+	case reflect.Interface:
+		if reflect.TypeOf(seg).Implements(t) {
+			return reflect.ValueOf(seg).Convert(t), nil
+		}
+		fallthrough
+	default:
+		return v, fmt.Errorf("unsupported map key type %s", t)
+	}
+	return v.Convert(t), nil
+}
+
+// JSONPatch renders changes as an RFC 6902 JSON Patch document, using "add", "remove" and
+// "replace" operations with RFC 6901 JSON Pointer paths.
+func JSONPatch(changes []Change) ([]byte, error) {
+	type op struct {
+		Op    string          `json:"op"`
+		Path  string          `json:"path"`
+		Value json.RawMessage `json:"value,omitempty"`
+	}
+	ops := make([]op, 0, len(changes))
+	for _, c := range changes {
+		o := op{Op: "replace", Path: jsonPointer(c.Path)}
+		switch c.Kind {
+		case Added:
+			o.Op = "add"
+		case Removed:
+			o.Op = "remove"
+		}
+		if c.Kind != Removed {
+			raw, err := json.Marshal(c.New)
+			if err != nil {
+				return nil, err
+			}
+			o.Value = raw
+		}
+		ops = append(ops, o)
+	}
+	return json.Marshal(ops)
+}
+
+func jsonPointer(path []string) string {
+	var b strings.Builder
+	escape := strings.NewReplacer("~", "~0", "/", "~1")
+	for _, s := range path {
+		b.WriteByte('/')
+		b.WriteString(escape.Replace(s))
+	}
+	return b.String()
+}
@@ -0,0 +1,191 @@
+package fn_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/kishankumarhs/fnkit/fn"
+)
+
+type member struct {
+	ID   int    `json:"id"`
+	Role string `json:"role"`
+}
+
+type team struct {
+	Name      string            `json:"name"`
+	Score     float64           `json:"score"`
+	Members   []member          `json:"members"`
+	Labels    map[string]string `json:"labels"`
+	Lead      *member           `json:"lead"`
+	Extra     any               `json:"extra"`
+	UpdatedAt time.Time         `json:"updated_at"`
+	note      string
+}
+
+func paths(changes []fn.Change) []string {
+	out := make([]string, len(changes))
+	for i, c := range changes {
+		out[i] = c.String()
+	}
+	return out
+}
+
+func TestDiff(t *testing.T) {
+	now := time.Now()
+	a := team{
+		Name:      "core",
+		Members:   []member{{1, "dev"}, {2, "dev"}, {3, "ops"}},
+		Labels:    map[string]string{"env": "prod", "tier": "1"},
+		Lead:      &member{1, "dev"},
+		Extra:     map[string]any{"n": 1.0},
+		UpdatedAt: now,
+		note:      "x",
+	}
+	b := team{
+		Name:      "platform",
+		Members:   []member{{1, "lead"}},
+		Labels:    map[string]string{"env": "prod", "region": "eu"},
+		Lead:      &member{1, "lead"},
+		Extra:     map[string]any{"n": 2.0},
+		UpdatedAt: now.Round(0), // same instant, no monotonic reading
+		note:      "y",
+	}
+	got := paths(fn.Diff(a, b))
+	want := []string{
+		`~ name: "core" -> "platform"`,
+		`~ members.0.role: "dev" -> "lead"`,
+		`- members.2: fn_test.member{ID:3, Role:"ops"}`,
+		`- members.1: fn_test.member{ID:2, Role:"dev"}`,
+		`+ labels.region: "eu"`,
+		`- labels.tier: "1"`,
+		`~ lead.role: "dev" -> "lead"`,
+		`~ extra.n: 1 -> 2`,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Diff =\n%q\nwant\n%q", got, want)
+	}
+	if len(fn.Diff(a, a)) != 0 {
+		t.Error("Diff of a value with itself should be empty")
+	}
+
+	if err := fn.Apply(&a, fn.Diff(a, b)); err != nil {
+		t.Fatal(err)
+	}
+	a.note = b.note
+	if len(fn.Diff(a, b)) != 0 {
+		t.Errorf("Apply did not reproduce b: %v", fn.Diff(a, b))
+	}
+	a.Members[0].Role = "changed"
+	if b.Members[0].Role != "lead" {
+		t.Error("Apply should copy new values, not alias them")
+	}
+}
+
+func TestDiffOptions(t *testing.T) {
+	a := team{Name: "a", Score: 1.0, Members: []member{{1, "dev"}, {2, "ops"}}, Labels: map[string]string{}}
+	b := team{Name: "b", Score: 1.0 + 1e-12, Members: []member{{2, "ops"}, {3, "qa"}, {1, "lead"}}}
+	opts := fn.DiffOptions{
+		IgnoreFields: []string{"Name"},
+		EquateEmpty:  true,
+		FloatEpsilon: 1e-9,
+		SliceKeys:    map[string]func(any) any{"members": func(m any) any { return m.(member).ID }},
+	}
+	got := paths(fn.DiffWith(a, b, opts))
+	want := []string{
+		`~ members.0.role: "dev" -> "lead"`,
+		`+ members.2: fn_test.member{ID:3, Role:"qa"}`,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("DiffWith =\n%q\nwant\n%q", got, want)
+	}
+	if err := fn.Apply(&a, fn.DiffWith(a, b, opts)); err != nil {
+		t.Fatal(err)
+	}
+	if len(fn.DiffWith(a, b, opts)) != 0 {
+		t.Errorf("Apply with keyed slices left %v", fn.DiffWith(a, b, opts))
+	}
+
+	nested := []team{{Members: []member{{1, "x"}}}}
+	if got := fn.DiffWith(nested, []team{{Members: []member{{1, "y"}}}}, fn.DiffOptions{IgnoreFields: []string{"*.members.*.role"}}); len(got) != 0 {
+		t.Errorf("wildcard IgnoreFields left %v", got)
+	}
+	if got := fn.Diff(team{Labels: map[string]string{}}, team{}); len(got) != 1 {
+		t.Errorf("nil and empty maps should differ without EquateEmpty, got %v", got)
+	}
+}
+
+func TestDiffCyclesAndRoots(t *testing.T) {
+	type ring struct {
+		V    int
+		Next *ring
+	}
+	a := &ring{V: 1}
+	a.Next = a
+	b := &ring{V: 2}
+	b.Next = b
+	if got := paths(fn.Diff(a, b)); !reflect.DeepEqual(got, []string{"~ V: 1 -> 2"}) {
+		t.Errorf("Diff on cycles = %q", got)
+	}
+	if got := paths(fn.Diff[any](1, "1")); !reflect.DeepEqual(got, []string{`~ (root): 1 -> "1"`}) {
+		t.Errorf("Diff with different types = %q", got)
+	}
+
+	v := []int{1, 2, 3}
+	if err := fn.Apply(&v, fn.Diff(v, []int{1})); err != nil || !reflect.DeepEqual(v, []int{1}) {
+		t.Errorf("Apply shrinking slice = %v, %v", v, err)
+	}
+	err := fn.Apply(&v, []fn.Change{{Path: []string{"5"}, Kind: fn.Removed}})
+	if !errors.Is(err, fn.ErrApply) {
+		t.Errorf("Apply out of range = %v, want ErrApply", err)
+	}
+}
+
+func TestJSONPatch(t *testing.T) {
+	a := map[string]any{"a/b": 1, "list": []any{"x"}, "gone": true}
+	b := map[string]any{"a/b": 2, "list": []any{"x", nil}, "new~": 0}
+	patch, err := fn.JSONPatch(fn.Diff(a, b))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `[{"op":"replace","path":"/a~1b","value":2},` +
+		`{"op":"remove","path":"/gone"},` +
+		`{"op":"add","path":"/list/1","value":null},` +
+		`{"op":"add","path":"/new~0","value":0}]`
+	if string(patch) != want {
+		t.Errorf("JSONPatch =\n%s\nwant\n%s", patch, want)
+	}
+}
+
+type audit struct {
+	By string `json:"by"`
+}
+
+type account struct {
+	audit
+	Name   string `json:"name"`
+	Secret string `json:"-"`
+}
+
+func TestDiffJSONFields(t *testing.T) {
+	a := account{audit{"ada"}, "x", "s1"}
+	b := account{audit{"bob"}, "y", "s2"}
+	changes := fn.Diff(a, b)
+	patch, err := fn.JSONPatch(changes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `[{"op":"replace","path":"/by","value":"bob"},` +
+		`{"op":"replace","path":"/name","value":"y"}]`
+	if string(patch) != want {
+		t.Errorf("JSONPatch =\n%s\nwant\n%s", patch, want)
+	}
+	if err := fn.Apply(&a, changes); err != nil {
+		t.Fatal(err)
+	}
+	if a.By != "bob" || a.Name != "y" || a.Secret != "s1" {
+		t.Errorf("Apply = %+v", a)
+	}
+}
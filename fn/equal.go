@@ -0,0 +1,225 @@
+package fn
+
+import (
+	"math"
+	"reflect"
+	"sort"
+)
+
+// EqualOption configures EqualWith.
+type EqualOption func(*equalConfig)
+
+type equalConfig struct {
+	ignore           map[string]bool
+	ignoreUnexported bool
+	equateEmpty      bool
+	approx           bool
+	fraction, margin float64
+	sorters          map[reflect.Type]func(a, b reflect.Value) bool
+	transformers     map[reflect.Type]func(reflect.Value) reflect.Value
+}
+
+// IgnoreFields skips struct fields with any of the given names (Go or json name), at any depth.
+func IgnoreFields(names ...string) EqualOption {
+	return func(c *equalConfig) {
+		if c.ignore == nil {
+			c.ignore = make(map[string]bool, len(names))
+		}
+		for _, n := range names {
+			c.ignore[n] = true
+		}
+	}
+}
+
+// IgnoreUnexported skips unexported struct fields.
+func IgnoreUnexported() EqualOption {
+	return func(c *equalConfig) { c.ignoreUnexported = true }
+}
+
+// EquateEmpty treats nil and empty slices and maps as equal.
+func EquateEmpty() EqualOption {
+	return func(c *equalConfig) { c.equateEmpty = true }
+}
+
+// EquateApprox treats floats x and y as equal when |x-y| <= max(margin, fraction*min(|x|, |y|)).
+func EquateApprox(fraction, margin float64) EqualOption {
+	return func(c *equalConfig) {
+		c.approx, c.fraction, c.margin = true, fraction, margin
+	}
+}
+
+// SortSlices compares slices of type []K as unordered, by sorting copies of them with less
+// first. Arrays of K are compared in order.
+func SortSlices[K any](less func(a, b K) bool) EqualOption {
+	return func(c *equalConfig) {
+		if c.sorters == nil {
+			c.sorters = make(map[reflect.Type]func(a, b reflect.Value) bool)
+		}
+		c.sorters[reflect.TypeFor[K]()] = func(a, b reflect.Value) bool {
+			return less(a.Interface().(K), b.Interface().(K))
+		}
+	}
+}
+
+// Transformer compares values of type K by comparing f of them instead, e.g. to normalise
+// case or to compare only part of a value. It is not applied again to its own result.
+func Transformer[K, T any](f func(K) T) EqualOption {
+	return func(c *equalConfig) {
+		if c.transformers == nil {
+			c.transformers = make(map[reflect.Type]func(reflect.Value) reflect.Value)
+		}
+		c.transformers[reflect.TypeFor[K]()] = func(v reflect.Value) reflect.Value {
+			var in K
+			reflect.ValueOf(&in).Elem().Set(v)
+			out := f(in)
+			return reflect.ValueOf(&out).Elem()
+		}
+	}
+}
+
+// EqualWith reports whether a and b are deeply equal, like DeepEqual, with the comparison
+// adjusted by opts. Unlike DeepEqual it honours Equal methods: values whose type has an
+// Equal(T) bool method, such as time.Time, are compared with it.
+//
+//	fn.EqualWith(got, want,
+//		fn.IgnoreFields("ID", "UpdatedAt"),
+//		fn.EquateEmpty(),
+//		fn.EquateApprox(0, 1e-9),
+//		fn.SortSlices(func(a, b string) bool { return a < b }),
+//	)
+func EqualWith[T any](a, b T, opts ...EqualOption) bool {
+	e := &equaler{}
+	for _, opt := range opts {
+		opt(&e.equalConfig)
+	}
+	return e.equal(reflect.ValueOf(&a).Elem(), reflect.ValueOf(&b).Elem(), nil)
+}
+
+type equaler struct {
+	equalConfig
+	visited map[visitPair]bool
+}
+
+// seen records that a and b are being compared and reports whether they already were,
+// in which case they are assumed equal, as reflect.DeepEqual does for cycles.
+func (e *equaler) seen(a, b reflect.Value) bool {
+	key := visitPair{a.Pointer(), b.Pointer(), a.Type()}
+	if e.visited == nil {
+		e.visited = make(map[visitPair]bool)
+	}
+	if e.visited[key] {
+		return true
+	}
+	e.visited[key] = true
+	return false
+}
+
+// equal compares a and b, which have the same type and can be interfaced. skip is the type
+// whose transformer produced them, if any.
+func (e *equaler) equal(a, b reflect.Value, skip reflect.Type) bool {
+	t := a.Type()
+	if f, ok := e.transformers[t]; ok && t != skip {
+		ta, tb := f(a), f(b)
+		return e.equal(ta, tb, t)
+	}
+	if eq, ok := equalMethod(a, b); ok {
+		return eq
+	}
+	switch a.Kind() {
+	case reflect.Interface:
+		if a.IsNil() || b.IsNil() {
+			return a.IsNil() == b.IsNil()
+		}
+		if a.Elem().Type() != b.Elem().Type() {
+			return false
+		}
+		return e.equal(a.Elem(), b.Elem(), nil)
+	case reflect.Pointer:
+		if a.Pointer() == b.Pointer() {
+			return true
+		}
+		if a.IsNil() || b.IsNil() || e.seen(a, b) {
+			return a.IsNil() == b.IsNil()
+		}
+		return e.equal(a.Elem(), b.Elem(), nil)
+	case reflect.Struct:
+		if !a.CanAddr() || !b.CanAddr() {
+			a, b = addressable(a), addressable(b)
+		}
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if e.ignore[f.Name] || e.ignore[jsonName(f)] || (!f.IsExported() && e.ignoreUnexported) {
+				continue
+			}
+			fa, fb := a.Field(i), b.Field(i)
+			if !f.IsExported() {
+				fa, fb = exposed(fa), exposed(fb)
+			}
+			if !e.equal(fa, fb, nil) {
+				return false
+			}
+		}
+		return true
+	case reflect.Map:
+		if a.Len() != b.Len() || (a.IsNil() != b.IsNil() && !e.equateEmpty) {
+			return false
+		}
+		if a.Pointer() == b.Pointer() || e.seen(a, b) {
+			return true
+		}
+		iter := a.MapRange()
+		for iter.Next() {
+			bv := b.MapIndex(iter.Key())
+			if !bv.IsValid() || !e.equal(iter.Value(), bv, nil) {
+				return false
+			}
+		}
+		return true
+	case reflect.Slice:
+		if a.Len() != b.Len() || (a.IsNil() != b.IsNil() && !e.equateEmpty) {
+			return false
+		}
+		if a.Len() == 0 || (a.Pointer() == b.Pointer() && e.sorters[t.Elem()] == nil) {
+			return true
+		}
+		if less, ok := e.sorters[t.Elem()]; ok {
+			a, b = sortedCopy(a, less), sortedCopy(b, less)
+		} else if e.seen(a, b) {
+			return true
+		}
+		fallthrough
+	case reflect.Array:
+		for i := 0; i < a.Len(); i++ {
+			if !e.equal(a.Index(i), b.Index(i), nil) {
+				return false
+			}
+		}
+		return true
+	case reflect.Float32, reflect.Float64:
+		x, y := a.Float(), b.Float()
+		if x == y || !e.approx {
+			return x == y
+		}
+		return math.Abs(x-y) <= max(e.margin, e.fraction*min(math.Abs(x), math.Abs(y)))
+	case reflect.Func:
+		return a.IsNil() && b.IsNil()
+	}
+	return a.Interface() == b.Interface()
+}
+
+// addressable returns v itself if it is addressable, otherwise an addressable copy.
+func addressable(v reflect.Value) reflect.Value {
+	if v.CanAddr() {
+		return v
+	}
+	c := reflect.New(v.Type()).Elem()
+	c.Set(v)
+	return c
+}
+
+func sortedCopy(s reflect.Value, less func(a, b reflect.Value) bool) reflect.Value {
+	c := reflect.MakeSlice(s.Type(), s.Len(), s.Len())
+	reflect.Copy(c, s)
+	sort.SliceStable(c.Interface(), func(i, j int) bool { return less(c.Index(i), c.Index(j)) })
+	return c
+}
@@ -0,0 +1,86 @@
+package fn_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kishankumarhs/fnkit/fn"
+)
+
+type record struct {
+	ID        int
+	Name      string
+	Tags      []string
+	Meta      map[string]any
+	Score     float64
+	CreatedAt time.Time `json:"created_at"`
+	Parent    *record
+	cache     []int
+}
+
+func TestEqualWith(t *testing.T) {
+	now := time.Now()
+	a := record{ID: 1, Name: "ada", Tags: []string{"x", "y"}, Score: 0.3, CreatedAt: now, cache: []int{1}}
+	b := record{ID: 1, Name: "ada", Tags: []string{"x", "y"}, Score: 0.3, CreatedAt: now.Round(0), cache: []int{1}}
+	if fn.DeepEqual(a, b) {
+		t.Fatal("test setup: monotonic readings should make DeepEqual fail")
+	}
+	if !fn.EqualWith(a, b) {
+		t.Error("EqualWith should use time.Time.Equal")
+	}
+
+	b.cache = []int{2}
+	if fn.EqualWith(a, b) {
+		t.Error("unexported fields should be compared by default")
+	}
+	if !fn.EqualWith(a, b, fn.IgnoreUnexported()) {
+		t.Error("IgnoreUnexported should skip them")
+	}
+	b.cache = a.cache
+
+	cases := []struct {
+		name string
+		edit func(r *record)
+		opts []fn.EqualOption
+	}{
+		{"IgnoreFields", func(r *record) { r.ID, r.CreatedAt = 2, time.Time{} }, []fn.EqualOption{fn.IgnoreFields("ID", "created_at")}},
+		{"EquateEmpty", func(r *record) { r.Meta = map[string]any{} }, []fn.EqualOption{fn.EquateEmpty()}},
+		{"EquateApprox", func(r *record) { tenth := 0.1; r.Score = tenth + 0.2 }, []fn.EqualOption{fn.EquateApprox(0, 1e-9)}},
+		{"SortSlices", func(r *record) { r.Tags = []string{"y", "x"} }, []fn.EqualOption{fn.SortSlices(func(a, b string) bool { return a < b })}},
+		{"Transformer", func(r *record) { r.Name = "ADA" }, []fn.EqualOption{fn.Transformer(strings.ToLower)}},
+	}
+	for _, c := range cases {
+		c2 := b
+		c.edit(&c2)
+		if fn.EqualWith(a, c2) {
+			t.Errorf("%s: values should differ without the option", c.name)
+		}
+		if !fn.EqualWith(a, c2, c.opts...) {
+			t.Errorf("%s: values should be equal with the option", c.name)
+		}
+	}
+	if !fn.EqualWith(b.Tags, []string{"y", "x"}, fn.SortSlices(func(a, b string) bool { return a < b })) || b.Tags[0] != "x" {
+		t.Error("SortSlices should compare sorted copies, leaving the input untouched")
+	}
+}
+
+func TestEqualWithNested(t *testing.T) {
+	x := &record{Name: "x", Meta: map[string]any{"n": 1.0, "l": []any{"a"}}}
+	x.Parent = x
+	y := &record{Name: "x", Meta: map[string]any{"n": 1.0000001, "l": []any{"a"}}}
+	y.Parent = y
+	if fn.EqualWith(x, y) {
+		t.Error("different nested floats should differ")
+	}
+	if !fn.EqualWith(x, y, fn.EquateApprox(1e-6, 0)) {
+		t.Error("EquateApprox should apply inside interfaces and maps")
+	}
+	if fn.EqualWith[any](1, int64(1)) || !fn.EqualWith[any](nil, nil) {
+		t.Error("interface comparison should follow dynamic types")
+	}
+	var f func()
+	if !fn.EqualWith(f, nil) || fn.EqualWith(func() {}, func() {}) {
+		t.Error("funcs are equal only when both are nil")
+	}
+}
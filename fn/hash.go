@@ -0,0 +1,215 @@
+package fn
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"hash/fnv"
+	"io"
+	"math"
+	"reflect"
+	"sort"
+	"time"
+)
+
+// Hash returns a 64-bit structural hash of v. Values that are DeepEqual hash equal, map
+// entries are hashed independently of iteration order, and the result is the same across
+// processes and platforms. Struct fields tagged `hash:"-"` are skipped.
+//
+// Pointers are followed, and channels and functions contribute only whether they are nil.
+// A time.Time is hashed by its instant and location name. Use Fingerprint where collisions
+// must be practically impossible.
+//
+// Cyclic values have no canonical encoding, since a node pointing to itself and a ring of
+// two equal nodes are DeepEqual, so Hash panics with an error wrapping ErrCyclic when a
+// pointer, map or slice contains itself. Values that merely share references are fine.
+func Hash(v any) uint64 {
+	h := fnv.New64a()
+	writeHash(h, v)
+	return h.Sum64()
+}
+
+// ErrCyclic is the error Hash, Fingerprint and KeyOf panic with on cyclic values.
+var ErrCyclic = errors.New("fn: cannot hash a cyclic value")
+
+// Fingerprint returns a SHA-256 digest of the same canonical encoding Hash uses, and
+// panics on cyclic values as Hash does.
+func Fingerprint(v any) []byte {
+	h := sha256.New()
+	writeHash(h, v)
+	return h.Sum(nil)
+}
+
+// HashKey is a comparable key standing for a value by its Fingerprint, so that values of
+// non-comparable types can key a Set or Map:
+//
+//	seen := fn.NewSet[fn.HashKey]()
+//	seen.Add(fn.KeyOf(event))
+type HashKey string
+
+// KeyOf returns the HashKey of v. Like Hash, it panics on cyclic values.
+func KeyOf(v any) HashKey {
+	return HashKey(Fingerprint(v))
+}
+
+func writeHash(h hash.Hash, v any) {
+	e := &hashEncoder{w: h, active: make(map[visitKey]bool)}
+	e.encode(reflect.ValueOf(&v).Elem())
+}
+
+// hashEncoder writes a canonical, self-delimiting encoding of values. Every value starts
+// with its kind, variable-length data is prefixed with its length, and active tracks the
+// pointers, maps and slices on the current path to detect cycles.
+type hashEncoder struct {
+	w      io.Writer
+	buf    [8]byte
+	active map[visitKey]bool
+}
+
+// enter records key as being encoded, panicking if it already is.
+func (e *hashEncoder) enter(key visitKey) {
+	if e.active[key] {
+		panic(fmt.Errorf("%w of type %s", ErrCyclic, key.typ))
+	}
+	e.active[key] = true
+}
+
+func (e *hashEncoder) uint(n uint64) {
+	binary.LittleEndian.PutUint64(e.buf[:], n)
+	e.w.Write(e.buf[:])
+}
+
+func (e *hashEncoder) bytes(b []byte) {
+	e.uint(uint64(len(b)))
+	e.w.Write(b)
+}
+
+func (e *hashEncoder) float(f float64) {
+	switch {
+	case f == 0:
+		f = 0 // -0 == 0
+	case math.IsNaN(f):
+		f = math.NaN()
+	}
+	e.uint(math.Float64bits(f))
+}
+
+var timeType = reflect.TypeFor[time.Time]()
+
+func (e *hashEncoder) encode(v reflect.Value) {
+	e.uint(uint64(v.Kind()))
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			e.uint(1)
+		} else {
+			e.uint(0)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		e.uint(uint64(v.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		e.uint(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		e.float(v.Float())
+	case reflect.Complex64, reflect.Complex128:
+		e.float(real(v.Complex()))
+		e.float(imag(v.Complex()))
+	case reflect.String:
+		e.bytes([]byte(v.String()))
+	case reflect.Interface:
+		if v.IsNil() {
+			e.uint(0)
+			return
+		}
+		e.uint(1)
+		e.bytes([]byte(v.Elem().Type().String()))
+		e.encode(v.Elem())
+	case reflect.Pointer:
+		if v.IsNil() {
+			e.uint(0)
+			return
+		}
+		key := visitKey{ptr: v.Pointer(), typ: v.Type()}
+		e.enter(key)
+		e.uint(1)
+		e.encode(v.Elem())
+		delete(e.active, key)
+	case reflect.Slice:
+		if v.Len() == 0 {
+			e.uint(0)
+			return
+		}
+		key := visitKey{ptr: v.Pointer(), typ: v.Type(), len: v.Len()}
+		e.enter(key)
+		e.encodeSeq(v)
+		delete(e.active, key)
+	case reflect.Array:
+		e.encodeSeq(v)
+	case reflect.Map:
+		if v.Len() == 0 {
+			e.uint(0)
+			return
+		}
+		key := visitKey{ptr: v.Pointer(), typ: v.Type()}
+		e.enter(key)
+		e.encodeMap(v)
+		delete(e.active, key)
+	case reflect.Struct:
+		if v.Type() == timeType {
+			t := v.Interface().(time.Time)
+			e.uint(uint64(t.Unix()))
+			e.uint(uint64(t.Nanosecond()))
+			e.bytes([]byte(t.Location().String()))
+			return
+		}
+		v = addressable(v)
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if f.Tag.Get("hash") == "-" {
+				continue
+			}
+			e.bytes([]byte(f.Name))
+			if f.IsExported() {
+				e.encode(v.Field(i))
+			} else {
+				e.encode(exposed(v.Field(i)))
+			}
+		}
+	default: // Chan, Func, UnsafePointer
+		if v.IsNil() {
+			e.uint(0)
+		} else {
+			e.uint(1)
+		}
+	}
+}
+
+func (e *hashEncoder) encodeSeq(v reflect.Value) {
+	e.uint(uint64(v.Len()))
+	for i := 0; i < v.Len(); i++ {
+		e.encode(v.Index(i))
+	}
+}
+
+// encodeMap encodes each entry separately and writes the encodings in sorted order, so
+// that the result does not depend on iteration order.
+func (e *hashEncoder) encodeMap(v reflect.Value) {
+	entries := make([][]byte, 0, v.Len())
+	iter := v.MapRange()
+	for iter.Next() {
+		var buf bytes.Buffer
+		sub := &hashEncoder{w: &buf, active: e.active}
+		sub.encode(iter.Key())
+		sub.encode(iter.Value())
+		entries = append(entries, buf.Bytes())
+	}
+	sort.Slice(entries, func(i, j int) bool { return bytes.Compare(entries[i], entries[j]) < 0 })
+	e.uint(uint64(len(entries)))
+	for _, b := range entries {
+		e.bytes(b)
+	}
+}
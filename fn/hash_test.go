@@ -0,0 +1,140 @@
+package fn_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/kishankumarhs/fnkit/fn"
+)
+
+type request struct {
+	Method  string
+	Query   map[string][]string
+	Body    any
+	At      time.Time
+	TraceID string `hash:"-"`
+	next    *request
+	weights []float64
+}
+
+func sample() request {
+	return request{
+		Method:  "GET",
+		Query:   map[string][]string{"a": {"1"}, "b": {"2", "3"}, "c": nil},
+		Body:    map[string]any{"x": 1.0, "y": []any{"z", nil}},
+		At:      time.Date(2025, 1, 2, 3, 4, 5, 6, time.UTC),
+		TraceID: "t1",
+		weights: []float64{0.5},
+	}
+}
+
+func TestHashConsistentWithDeepEqual(t *testing.T) {
+	a, b := sample(), sample()
+	if !fn.DeepEqual(a, b) {
+		t.Fatal("test setup: samples should be DeepEqual")
+	}
+	for i := 0; i < 20; i++ { // map iteration order varies between runs
+		if fn.Hash(a) != fn.Hash(b) || !bytes.Equal(fn.Fingerprint(a), fn.Fingerprint(b)) {
+			t.Fatal("DeepEqual values must hash equal")
+		}
+	}
+
+	b.TraceID = "t2"
+	if fn.Hash(a) != fn.Hash(b) {
+		t.Error(`fields tagged hash:"-" should be skipped`)
+	}
+	b.At = a.At.In(time.FixedZone("X", 0))
+	if fn.Hash(a) == fn.Hash(b) {
+		t.Error("a different location should change the hash")
+	}
+
+	edits := []func(r *request){
+		func(r *request) { r.Method = "POST" },
+		func(r *request) { r.Query["a"] = []string{"2"} },
+		func(r *request) { r.Query["d"] = nil },
+		func(r *request) { r.Body = map[string]any{"x": 1} },
+		func(r *request) { r.weights[0] = 0.25 },
+		func(r *request) { r.At = r.At.Add(time.Nanosecond) },
+	}
+	base := fn.Hash(sample())
+	for i, edit := range edits {
+		r := sample()
+		edit(&r)
+		if fn.Hash(r) == base {
+			t.Errorf("edit %d did not change the hash", i)
+		}
+	}
+}
+
+func TestHashEdgeCases(t *testing.T) {
+	if fn.Hash(math.Copysign(0, -1)) != fn.Hash(0.0) {
+		t.Error("-0 and 0 are equal and must hash equal")
+	}
+	if fn.Hash([]string{"ab", "c"}) == fn.Hash([]string{"a", "bc"}) {
+		t.Error("encoding should be unambiguous")
+	}
+	if fn.Hash(time.Now()) == 0 {
+		t.Error("unexpected zero hash")
+	}
+
+	// The encoding is part of the contract: it must not change between releases.
+	if got := hex.EncodeToString(fn.Fingerprint(map[string]int{"a": 1, "b": 2})); got != fingerprintGolden {
+		t.Errorf("Fingerprint changed: %s", got)
+	}
+}
+
+const fingerprintGolden = "9eaa373194c76ee1d6b851b7f7c2580a5918db7b650ca35412fbca561e804ee8"
+
+func TestHashKey(t *testing.T) {
+	seen := fn.NewSet[fn.HashKey]()
+	seen.Add(fn.KeyOf([]int{1, 2}))
+	if !seen.Has(fn.KeyOf([]int{1, 2})) || seen.Has(fn.KeyOf([]int{2, 1})) {
+		t.Error("HashKey should identify slices by content")
+	}
+
+	cache := fn.NewMap[fn.HashKey, string]()
+	cache.Set(fn.KeyOf(sample()), "cached")
+	if v, ok := cache.Get(fn.KeyOf(sample())); !ok || v != "cached" {
+		t.Error("HashKey should work as a Map key")
+	}
+}
+
+func TestHashCycles(t *testing.T) {
+	mustPanic := func(name string, v any) {
+		t.Helper()
+		defer func() {
+			err, _ := recover().(error)
+			if !errors.Is(err, fn.ErrCyclic) {
+				t.Errorf("Hash(%s) panicked with %v, want ErrCyclic", name, err)
+			}
+		}()
+		fn.Hash(v)
+	}
+
+	r := &request{Method: "loop"}
+	r.next = r
+	mustPanic("self-loop", r)
+
+	x := &request{Method: "loop"}
+	x.next = &request{Method: "loop", next: x}
+	mustPanic("two-node ring", x)
+
+	m := map[string]any{"n": 1}
+	m["self"] = m
+	mustPanic("self-referencing map", m)
+
+	s := []any{nil, 2}
+	s[0] = s
+	mustPanic("self-referencing slice", s)
+
+	// Shared references without a cycle are not rejected.
+	shared := &request{Method: "shared"}
+	pair := []*request{shared, shared}
+	if fn.Hash(pair) != fn.Hash([]*request{{Method: "shared"}, {Method: "shared"}}) {
+		t.Error("shared pointers should hash like equal copies")
+	}
+}
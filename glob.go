@@ -0,0 +1,186 @@
+package fnkit
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// GlobOptions configures CompileGlob.
+type GlobOptions struct {
+	CaseInsensitive bool
+	// Separator is the path separator that *, ? and negated character classes never
+	// match; a class that lists it, such as [/], does. It defaults to '/'.
+	Separator rune
+}
+
+// Glob is a compiled wildcard pattern. It is safe for concurrent use.
+//
+// Patterns support:
+//
+//   - "*" matches any run of characters except the separator.
+//   - "**" matches any run of characters including separators; "a/**/b" also matches "a/b".
+//   - "?" matches any single character except the separator.
+//   - "[a-z]" is a character class, and "[!a-z]" or "[^a-z]" negates it. A negated class
+//     never matches the separator.
+//   - "{a,b}" matches either alternative; alternatives may nest and contain wildcards.
+//   - "\x" matches the literal character x.
+type Glob struct {
+	pattern string
+	re      *regexp.Regexp
+}
+
+// CompileGlob parses pattern and returns a Glob that can be matched repeatedly.
+func CompileGlob(pattern string, opts GlobOptions) (*Glob, error) {
+	sep := opts.Separator
+	if sep == 0 {
+		sep = '/'
+	}
+	expr, err := globToRegexp(pattern, sep)
+	if err != nil {
+		return nil, fmt.Errorf("glob %q: %w", pattern, err)
+	}
+	if opts.CaseInsensitive {
+		expr = "(?i)" + expr
+	}
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, fmt.Errorf("glob %q: %w", pattern, err)
+	}
+	return &Glob{pattern: pattern, re: re}, nil
+}
+
+// MustCompileGlob is like CompileGlob but panics if the pattern is invalid.
+func MustCompileGlob(pattern string, opts GlobOptions) *Glob {
+	g, err := CompileGlob(pattern, opts)
+	if err != nil {
+		panic(err)
+	}
+	return g
+}
+
+// Match reports whether s matches the whole pattern.
+func (g *Glob) Match(s string) bool {
+	return g.re.MatchString(s)
+}
+
+// String returns the source pattern.
+func (g *Glob) String() string {
+	return g.pattern
+}
+
+// MatchGlob reports whether s matches the wildcard pattern, using '/' as the separator.
+// See Glob for the syntax. Invalid patterns match nothing; use CompileGlob to see the error
+// and to avoid recompiling a pattern that is matched often.
+func MatchGlob(pattern, s string) bool {
+	g, err := CompileGlob(pattern, GlobOptions{})
+	return err == nil && g.Match(s)
+}
+
+// FilterGlob returns the elements of s that match the wildcard pattern.
+// Invalid patterns match nothing.
+func FilterGlob(s []string, pattern string) []string {
+	g, err := CompileGlob(pattern, GlobOptions{})
+	if err != nil {
+		return nil
+	}
+	return ToFilter(s, g.Match)
+}
+
+// globToRegexp translates a glob pattern into an anchored regular expression.
+func globToRegexp(pattern string, sep rune) (string, error) {
+	quotedSep := regexp.QuoteMeta(string(sep))
+	notSep := "[^" + quotedSep + "]"
+	runes := []rune(pattern)
+	var b strings.Builder
+	b.WriteString("^(?s:")
+	depth := 0
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch r {
+		case '\\':
+			i++
+			if i == len(runes) {
+				return "", fmt.Errorf("trailing backslash")
+			}
+			b.WriteString(regexp.QuoteMeta(string(runes[i])))
+		case '*':
+			if i+1 < len(runes) && runes[i+1] == '*' {
+				atSegmentStart := i == 0 || runes[i-1] == sep
+				i++
+				if atSegmentStart && i+1 < len(runes) && runes[i+1] == sep {
+					b.WriteString("(?:.*" + quotedSep + ")?")
+					i++
+				} else {
+					b.WriteString(".*")
+				}
+			} else {
+				b.WriteString(notSep + "*")
+			}
+		case '?':
+			b.WriteString(notSep)
+		case '[':
+			end := i + 1
+			if end < len(runes) && (runes[end] == '!' || runes[end] == '^') {
+				end++
+			}
+			if end < len(runes) && runes[end] == ']' {
+				end++
+			}
+			for end < len(runes) && runes[end] != ']' {
+				end++
+			}
+			if end == len(runes) {
+				return "", fmt.Errorf("unterminated character class")
+			}
+			b.WriteString(globClass(runes[i+1:end], quotedSep))
+			i = end
+		case '{':
+			depth++
+			b.WriteString("(?:")
+		case '}':
+			if depth == 0 {
+				return "", fmt.Errorf("unmatched '}'")
+			}
+			depth--
+			b.WriteString(")")
+		case ',':
+			if depth > 0 {
+				b.WriteString("|")
+			} else {
+				b.WriteString(",")
+			}
+		default:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	if depth > 0 {
+		return "", fmt.Errorf("unterminated '{'")
+	}
+	b.WriteString(")$")
+	return b.String(), nil
+}
+
+// globClass translates the body of a [...] class into a regular expression class.
+func globClass(body []rune, quotedSep string) string {
+	var b strings.Builder
+	b.WriteByte('[')
+	negate := len(body) > 0 && (body[0] == '!' || body[0] == '^')
+	if negate {
+		b.WriteByte('^')
+		b.WriteString(quotedSep)
+		body = body[1:]
+	}
+	for _, r := range body {
+		if r != '-' && r < 0x80 && !isASCIIAlnum(r) {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	b.WriteByte(']')
+	return b.String()
+}
+
+func isASCIIAlnum(r rune) bool {
+	return r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9'
+}
@@ -0,0 +1,68 @@
+package fnkit
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMatchGlob(t *testing.T) {
+	cases := []struct {
+		pattern, s string
+		want       bool
+	}{
+		{"*.go", "main.go", true},
+		{"*.go", "cmd/main.go", false},
+		{"**/*.go", "cmd/main.go", true},
+		{"**/*.go", "main.go", true},
+		{"src/**/test/*.js", "src/test/a.js", true},
+		{"src/**/test/*.js", "src/a/b/test/a.js", true},
+		{"src/**", "src/a/b", true},
+		{"file?.txt", "file1.txt", true},
+		{"file?.txt", "file10.txt", false},
+		{"[a-c]at", "bat", true},
+		{"[a-c]at", "rat", false},
+		{"[!a-c]at", "rat", true},
+		{"[^a-c]at", "bat", false},
+		{"a[/]b", "a/b", true},
+		{"a[!x]b", "a/b", false},
+		{"[/]", "/", true},
+		{"[!a]", "/", false},
+		{"*.{jpg,png}", "photo.png", true},
+		{"*.{jpg,png}", "photo.gif", false},
+		{"{api,web}/{v1,v2/*}", "web/v2/users", true},
+		{`\*literal`, "*literal", true},
+		{`\*literal`, "xliteral", false},
+		{"a.b", "axb", false},
+		{"user:*", "user:42", true},
+		{"[]]", "]", true},
+		{"", "", true},
+		{"{unclosed", "{unclosed", false},
+	}
+	for _, c := range cases {
+		if got := MatchGlob(c.pattern, c.s); got != c.want {
+			t.Errorf("MatchGlob(%q, %q) = %v, want %v", c.pattern, c.s, got, c.want)
+		}
+	}
+}
+
+func TestCompileGlob(t *testing.T) {
+	g := MustCompileGlob("*.EXAMPLE.com", GlobOptions{CaseInsensitive: true, Separator: '.'})
+	if !g.Match("api.example.COM") || g.Match("a.b.example.com") {
+		t.Errorf("Glob(%s) host matching failed", g)
+	}
+	for _, bad := range []string{"{a,b", "a}", "[abc", `abc\`} {
+		if _, err := CompileGlob(bad, GlobOptions{}); err == nil {
+			t.Errorf("CompileGlob(%q) expected error", bad)
+		}
+	}
+}
+
+func TestFilterGlob(t *testing.T) {
+	files := []string{"a.go", "b_test.go", "README.md", "cmd/c.go"}
+	if got := FilterGlob(files, "*.go"); !reflect.DeepEqual(got, []string{"a.go", "b_test.go"}) {
+		t.Errorf("FilterGlob() = %q", got)
+	}
+	if got := FilterGlob(files, "{"); got != nil {
+		t.Errorf("FilterGlob() invalid = %q", got)
+	}
+}
@@ -0,0 +1,353 @@
+package fnkit
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Graphemes splits s into extended grapheme clusters (UAX #29), the units a reader perceives
+// as single characters: "e" followed by a combining accent, a flag made of two regional
+// indicators, an emoji ZWJ sequence such as "👩‍💻", or a Devanagari conjunct such as "क्षि"
+// are each one cluster. All rules of UAX #29 (Unicode 15.1) are applied; the Unicode
+// property tables behind them are compact approximations covering the common scripts.
+func Graphemes(s string) []string {
+	var out []string
+	for s != "" {
+		n := graphemeLen(s)
+		out = append(out, s[:n])
+		s = s[n:]
+	}
+	return out
+}
+
+// GraphemeLen returns the number of grapheme clusters in s.
+func GraphemeLen(s string) int {
+	count := 0
+	for s != "" {
+		s = s[graphemeLen(s):]
+		count++
+	}
+	return count
+}
+
+// Truncate shortens s to at most n grapheme clusters, replacing the tail with ellipsis
+// when s is cut. If n leaves no room for the ellipsis, s is cut to n clusters without it.
+func Truncate(s string, n int, ellipsis string) string {
+	if n <= 0 {
+		return ""
+	}
+	if GraphemeLen(s) <= n {
+		return s
+	}
+	keep := n - GraphemeLen(ellipsis)
+	if keep <= 0 {
+		return graphemePrefix(s, n)
+	}
+	return graphemePrefix(s, keep) + ellipsis
+}
+
+// DisplayWidth returns the number of terminal columns s occupies. East Asian wide and
+// full-width characters and emoji take two columns, combining marks and control characters
+// take none, and everything else takes one.
+func DisplayWidth(s string) int {
+	width := 0
+	for s != "" {
+		n := graphemeLen(s)
+		width += clusterWidth(s[:n])
+		s = s[n:]
+	}
+	return width
+}
+
+// graphemePrefix returns the first n grapheme clusters of s.
+func graphemePrefix(s string, n int) string {
+	end := 0
+	for i := 0; i < n && end < len(s); i++ {
+		end += graphemeLen(s[end:])
+	}
+	return s[:end]
+}
+
+// padding returns enough copies of padChar to fill width display columns.
+func padding(padChar rune, width int) string {
+	w := runeWidth(padChar)
+	if w == 0 {
+		w = 1
+	}
+	if width <= 0 {
+		return ""
+	}
+	// A wide padChar cannot fill an odd remainder; a space makes up the last column.
+	return strings.Repeat(string(padChar), width/w) + strings.Repeat(" ", width%w)
+}
+
+// clusterWidth returns the display width of a single grapheme cluster.
+func clusterWidth(cluster string) int {
+	r, size := utf8.DecodeRuneInString(cluster)
+	w := runeWidth(r)
+	if w == 0 {
+		return 0
+	}
+	if graphemeProperty(r) == gpRegionalIndicator || (size < len(cluster) && strings.ContainsRune(cluster, '\ufe0f')) {
+		return 2
+	}
+	return w
+}
+
+// runeWidth returns the display width of a single rune.
+func runeWidth(r rune) int {
+	switch {
+	case r == 0:
+		return 0
+	case r < 0x20 || (r >= 0x7F && r < 0xA0):
+		return 0
+	case unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf):
+		return 0
+	case inRanges(r, eastAsianWide):
+		return 2
+	default:
+		return 1
+	}
+}
+
+type graphemeProp int
+
+const (
+	gpOther graphemeProp = iota
+	gpCR
+	gpLF
+	gpControl
+	gpExtend
+	gpZWJ
+	gpRegionalIndicator
+	gpSpacingMark
+	gpL
+	gpV
+	gpT
+	gpLV
+	gpLVT
+	gpExtPict
+	gpPrepend
+)
+
+// conjunctState tracks GB9c: whether the cluster so far ends in an Indic consonant followed
+// by extending marks (conjunctConsonant), with at least one virama among them (conjunctLinker).
+type conjunctState int
+
+const (
+	conjunctNone conjunctState = iota
+	conjunctConsonant
+	conjunctLinker
+)
+
+// next returns the state after appending a rune with grapheme property p to the cluster.
+func (c conjunctState) next(r rune, p graphemeProp) conjunctState {
+	switch {
+	case isConjunctConsonant(r):
+		return conjunctConsonant
+	case isConjunctLinker(r):
+		if c != conjunctNone {
+			return conjunctLinker
+		}
+	case p == gpExtend || p == gpZWJ:
+		return c
+	}
+	return conjunctNone
+}
+
+// graphemeLen returns the byte length of the first grapheme cluster in s.
+func graphemeLen(s string) int {
+	r, pos := utf8.DecodeRuneInString(s)
+	prev := graphemeProperty(r)
+	pictSeq := prev == gpExtPict
+	regional := 0
+	if prev == gpRegionalIndicator {
+		regional = 1
+	}
+	conjunct := conjunctNone.next(r, prev)
+	for pos < len(s) {
+		r, size := utf8.DecodeRuneInString(s[pos:])
+		cur := graphemeProperty(r)
+		if !graphemeJoins(prev, cur, pictSeq, regional) && !(conjunct == conjunctLinker && isConjunctConsonant(r)) { // GB9c
+			break
+		}
+		conjunct = conjunct.next(r, cur)
+		switch cur {
+		case gpExtend, gpZWJ:
+		case gpExtPict:
+			pictSeq = true
+		default:
+			pictSeq = false
+		}
+		if cur == gpRegionalIndicator {
+			regional++
+		}
+		prev = cur
+		pos += size
+	}
+	return pos
+}
+
+// graphemeJoins reports whether there is no cluster boundary between runes with properties
+// prev and cur. pictSeq is true while the cluster so far matches ExtPict Extend* ZWJ?, and
+// regional counts the regional indicators already in the cluster. GB9c, which depends on
+// the Indic_Conjunct_Break property rather than on prev, is checked by graphemeLen.
+func graphemeJoins(prev, cur graphemeProp, pictSeq bool, regional int) bool {
+	switch {
+	case prev == gpCR && cur == gpLF: // GB3
+		return true
+	case prev == gpCR || prev == gpLF || prev == gpControl: // GB4
+		return false
+	case cur == gpCR || cur == gpLF || cur == gpControl: // GB5
+		return false
+	case prev == gpL && (cur == gpL || cur == gpV || cur == gpLV || cur == gpLVT): // GB6
+		return true
+	case (prev == gpLV || prev == gpV) && (cur == gpV || cur == gpT): // GB7
+		return true
+	case (prev == gpLVT || prev == gpT) && cur == gpT: // GB8
+		return true
+	case cur == gpExtend || cur == gpZWJ || cur == gpSpacingMark: // GB9, GB9a
+		return true
+	case prev == gpPrepend: // GB9b
+		return true
+	case prev == gpZWJ && cur == gpExtPict && pictSeq: // GB11
+		return true
+	case prev == gpRegionalIndicator && cur == gpRegionalIndicator: // GB12, GB13
+		return regional%2 == 1
+	}
+	return false // GB999
+}
+
+func graphemeProperty(r rune) graphemeProp {
+	switch {
+	case r == '\r':
+		return gpCR
+	case r == '\n':
+		return gpLF
+	case r == '\u200d':
+		return gpZWJ
+	case r == '\u200c', r >= 0x1F3FB && r <= 0x1F3FF, r >= 0xE0020 && r <= 0xE007F, r == 0xFF9E, r == 0xFF9F:
+		return gpExtend
+	case unicode.In(r, unicode.Mn, unicode.Me):
+		return gpExtend
+	case inRanges(r, prepend):
+		return gpPrepend
+	case unicode.In(r, unicode.Cc, unicode.Zl, unicode.Zp, unicode.Cf):
+		return gpControl
+	case unicode.Is(unicode.Mc, r):
+		return gpSpacingMark
+	case r >= 0x1F1E6 && r <= 0x1F1FF:
+		return gpRegionalIndicator
+	case r >= 0x1100 && r <= 0x115F, r >= 0xA960 && r <= 0xA97C:
+		return gpL
+	case r >= 0x1160 && r <= 0x11A7, r >= 0xD7B0 && r <= 0xD7C6:
+		return gpV
+	case r >= 0x11A8 && r <= 0x11FF, r >= 0xD7CB && r <= 0xD7FB:
+		return gpT
+	case r >= 0xAC00 && r <= 0xD7A3:
+		if (r-0xAC00)%28 == 0 {
+			return gpLV
+		}
+		return gpLVT
+	case inRanges(r, extendedPictographic):
+		return gpExtPict
+	}
+	return gpOther
+}
+
+// inRanges reports whether r falls in one of the sorted, inclusive ranges.
+func inRanges(r rune, ranges [][2]rune) bool {
+	i := sort.Search(len(ranges), func(i int) bool { return ranges[i][1] >= r })
+	return i < len(ranges) && ranges[i][0] <= r
+}
+
+// prepend lists the Grapheme_Cluster_Break=Prepend ranges: signs such as the Arabic number
+// sign that attach to the character after them.
+var prepend = [][2]rune{
+	{0x0600, 0x0605}, {0x06DD, 0x06DD}, {0x070F, 0x070F}, {0x0890, 0x0891},
+	{0x08E2, 0x08E2}, {0x0D4E, 0x0D4E}, {0x110BD, 0x110BD}, {0x110CD, 0x110CD},
+	{0x111C2, 0x111C3}, {0x1193F, 0x1193F}, {0x11941, 0x11941}, {0x11A3A, 0x11A3A},
+	{0x11A84, 0x11A89}, {0x11D46, 0x11D46}, {0x11F02, 0x11F02},
+}
+
+// isConjunctLinker reports whether r has Indic_Conjunct_Break=Linker: the viramas of the
+// scripts whose consonants form conjuncts across them.
+func isConjunctLinker(r rune) bool {
+	switch r {
+	case 0x094D, 0x09CD, 0x0ACD, 0x0B4D, 0x0C4D, 0x0D4D:
+		return true
+	}
+	return false
+}
+
+// isConjunctConsonant reports whether r has Indic_Conjunct_Break=Consonant.
+func isConjunctConsonant(r rune) bool {
+	return inRanges(r, conjunctConsonants)
+}
+
+// conjunctConsonants lists the consonants of Devanagari, Bengali, Gujarati, Oriya, Telugu
+// and Malayalam (Indic_Conjunct_Break=Consonant).
+var conjunctConsonants = [][2]rune{
+	{0x0915, 0x0939}, {0x0958, 0x095F}, {0x0978, 0x097F},
+	{0x0995, 0x09A8}, {0x09AA, 0x09B0}, {0x09B2, 0x09B2}, {0x09B6, 0x09B9},
+	{0x09DC, 0x09DD}, {0x09DF, 0x09DF}, {0x09F0, 0x09F1},
+	{0x0A95, 0x0AA8}, {0x0AAA, 0x0AB0}, {0x0AB2, 0x0AB3}, {0x0AB5, 0x0AB9}, {0x0AF9, 0x0AF9},
+	{0x0B15, 0x0B28}, {0x0B2A, 0x0B30}, {0x0B32, 0x0B33}, {0x0B35, 0x0B39},
+	{0x0B5C, 0x0B5D}, {0x0B5F, 0x0B5F}, {0x0B71, 0x0B71},
+	{0x0C15, 0x0C28}, {0x0C2A, 0x0C39}, {0x0C58, 0x0C5A},
+	{0x0D15, 0x0D3A},
+}
+
+// extendedPictographic approximates the Extended_Pictographic property (emoji and symbols
+// that can start an emoji ZWJ sequence).
+var extendedPictographic = [][2]rune{
+	{0x00A9, 0x00A9}, {0x00AE, 0x00AE}, {0x203C, 0x203C}, {0x2049, 0x2049},
+	{0x2122, 0x2122}, {0x2139, 0x2139}, {0x2194, 0x2199}, {0x21A9, 0x21AA},
+	{0x231A, 0x231B}, {0x2328, 0x2328}, {0x2388, 0x2388}, {0x23CF, 0x23CF},
+	{0x23E9, 0x23F3}, {0x23F8, 0x23FA}, {0x24C2, 0x24C2}, {0x25AA, 0x25AB},
+	{0x25B6, 0x25B6}, {0x25C0, 0x25C0}, {0x25FB, 0x25FE}, {0x2600, 0x2605},
+	{0x2607, 0x2612}, {0x2614, 0x2685}, {0x2690, 0x2705}, {0x2708, 0x2712},
+	{0x2714, 0x2714}, {0x2716, 0x2716}, {0x271D, 0x271D}, {0x2721, 0x2721},
+	{0x2728, 0x2728}, {0x2733, 0x2734}, {0x2744, 0x2744}, {0x2747, 0x2747},
+	{0x274C, 0x274C}, {0x274E, 0x274E}, {0x2753, 0x2755}, {0x2757, 0x2757},
+	{0x2763, 0x2767}, {0x2795, 0x2797}, {0x27A1, 0x27A1}, {0x27B0, 0x27B0},
+	{0x27BF, 0x27BF}, {0x2934, 0x2935}, {0x2B05, 0x2B07}, {0x2B1B, 0x2B1C},
+	{0x2B50, 0x2B50}, {0x2B55, 0x2B55}, {0x3030, 0x3030}, {0x303D, 0x303D},
+	{0x3297, 0x3297}, {0x3299, 0x3299}, {0x1F000, 0x1F0FF}, {0x1F10D, 0x1F10F},
+	{0x1F12F, 0x1F12F}, {0x1F16C, 0x1F171}, {0x1F17E, 0x1F17F}, {0x1F18E, 0x1F18E},
+	{0x1F191, 0x1F19A}, {0x1F1AD, 0x1F1E5}, {0x1F201, 0x1F20F}, {0x1F21A, 0x1F21A},
+	{0x1F22F, 0x1F22F}, {0x1F232, 0x1F23A}, {0x1F23C, 0x1F23F}, {0x1F249, 0x1F3FA},
+	{0x1F400, 0x1F53D}, {0x1F546, 0x1F64F}, {0x1F680, 0x1F6FF}, {0x1F774, 0x1F77F},
+	{0x1F7D5, 0x1F7FF}, {0x1F80C, 0x1F80F}, {0x1F848, 0x1F84F}, {0x1F85A, 0x1F85F},
+	{0x1F888, 0x1F88F}, {0x1F8AE, 0x1F8FF}, {0x1F90C, 0x1F93A}, {0x1F93C, 0x1F945},
+	{0x1F947, 0x1FAFF}, {0x1FC00, 0x1FFFD},
+}
+
+// eastAsianWide lists the East Asian Wide (W) and Fullwidth (F) ranges rendered in two columns.
+var eastAsianWide = [][2]rune{
+	{0x1100, 0x115F}, {0x231A, 0x231B}, {0x2329, 0x232A}, {0x23E9, 0x23EC},
+	{0x23F0, 0x23F0}, {0x23F3, 0x23F3}, {0x25FD, 0x25FE}, {0x2614, 0x2615},
+	{0x2648, 0x2653}, {0x267F, 0x267F}, {0x2693, 0x2693}, {0x26A1, 0x26A1},
+	{0x26AA, 0x26AB}, {0x26BD, 0x26BE}, {0x26C4, 0x26C5}, {0x26CE, 0x26CE},
+	{0x26D4, 0x26D4}, {0x26EA, 0x26EA}, {0x26F2, 0x26F3}, {0x26F5, 0x26F5},
+	{0x26FA, 0x26FA}, {0x26FD, 0x26FD}, {0x2705, 0x2705}, {0x270A, 0x270B},
+	{0x2728, 0x2728}, {0x274C, 0x274C}, {0x274E, 0x274E}, {0x2753, 0x2755},
+	{0x2757, 0x2757}, {0x2795, 0x2797}, {0x27B0, 0x27B0}, {0x27BF, 0x27BF},
+	{0x2B1B, 0x2B1C}, {0x2B50, 0x2B50}, {0x2B55, 0x2B55}, {0x2E80, 0x303E},
+	{0x3041, 0x33FF}, {0x3400, 0x4DBF}, {0x4E00, 0x9FFF}, {0xA000, 0xA4CF},
+	{0xA960, 0xA97F}, {0xAC00, 0xD7A3}, {0xF900, 0xFAFF}, {0xFE10, 0xFE19},
+	{0xFE30, 0xFE6F}, {0xFF00, 0xFF60}, {0xFFE0, 0xFFE6}, {0x16FE0, 0x16FE4},
+	{0x17000, 0x18AFF}, {0x1B000, 0x1B2FF}, {0x1F004, 0x1F004}, {0x1F0CF, 0x1F0CF},
+	{0x1F18E, 0x1F18E}, {0x1F191, 0x1F19A}, {0x1F200, 0x1F202}, {0x1F210, 0x1F23B},
+	{0x1F240, 0x1F248}, {0x1F250, 0x1F251}, {0x1F260, 0x1F265}, {0x1F300, 0x1F320},
+	{0x1F32D, 0x1F335}, {0x1F337, 0x1F37C}, {0x1F37E, 0x1F393}, {0x1F3A0, 0x1F3CA},
+	{0x1F3CF, 0x1F3D3}, {0x1F3E0, 0x1F3F0}, {0x1F3F4, 0x1F3F4}, {0x1F3F8, 0x1F43E},
+	{0x1F440, 0x1F440}, {0x1F442, 0x1F4FC}, {0x1F4FF, 0x1F53D}, {0x1F54B, 0x1F54E},
+	{0x1F550, 0x1F567}, {0x1F57A, 0x1F57A}, {0x1F595, 0x1F596}, {0x1F5A4, 0x1F5A4},
+	{0x1F5FB, 0x1F64F}, {0x1F680, 0x1F6C5}, {0x1F6CC, 0x1F6CC}, {0x1F6D0, 0x1F6D2},
+	{0x1F6D5, 0x1F6D7}, {0x1F6DC, 0x1F6DF}, {0x1F6EB, 0x1F6EC}, {0x1F6F4, 0x1F6FC},
+	{0x1F7E0, 0x1F7EB}, {0x1F7F0, 0x1F7F0}, {0x1F90C, 0x1F93A}, {0x1F93C, 0x1F945},
+	{0x1F947, 0x1F9FF}, {0x1FA70, 0x1FAFF}, {0x20000, 0x2FFFD}, {0x30000, 0x3FFFD},
+}
@@ -0,0 +1,82 @@
+package fnkit
+
+import "testing"
+
+func TestGraphemes(t *testing.T) {
+	cases := []struct {
+		in   string
+		want int
+	}{
+		{"", 0},
+		{"abc", 3},
+		{"e\u0301", 1},                    // e + combining acute
+		{"\U0001F469\u200d\U0001F4BB", 1}, // woman technologist ZWJ sequence
+		{"\U0001F1EF\U0001F1F5\U0001F1FA\U0001F1F8", 2}, // two flags
+		{"\U0001F44D\U0001F3FD", 1},                     // thumbs up + skin tone
+		{"\r\n", 1},
+		{"\u1100\u1161\u11a8", 1},                   // Hangul jamo L V T
+		{"\u0915\u094d\u0937\u093f", 1},             // क्षि: conjunct across a virama (GB9c)
+		{"\u0928\u092e\u0938\u094d\u0924\u0947", 3}, // नमस्ते: न म स्ते
+		{"\u0915\u094d\u200d\u0937", 1},             // virama + ZWJ still joins
+		{"\u0915\u093f\u0937", 2},                   // no virama, no conjunct
+		{"\u0600\u0661\u0662", 2},                   // Arabic number sign prepends (GB9b)
+		{"\u0600\n", 2},                             // but not to a control
+	}
+	for _, c := range cases {
+		if got := GraphemeLen(c.in); got != c.want {
+			t.Errorf("GraphemeLen(%q) = %d, want %d (%q)", c.in, got, c.want, Graphemes(c.in))
+		}
+	}
+}
+
+func TestReverseStringGraphemes(t *testing.T) {
+	if got := ReverseString("cafe\u0301!"); got != "!e\u0301fac" {
+		t.Errorf("ReverseString() combining = %q", got)
+	}
+	family := "\U0001F468\u200d\U0001F469\u200d\U0001F467"
+	if got := ReverseString("a" + family + "b"); got != "b"+family+"a" {
+		t.Errorf("ReverseString() ZWJ = %q", got)
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	if got := Truncate("hello world", 8, "..."); got != "hello..." {
+		t.Errorf("Truncate() = %q", got)
+	}
+	if got := Truncate("short", 10, "..."); got != "short" {
+		t.Errorf("Truncate() no-op = %q", got)
+	}
+	if got := Truncate("\U0001F1EF\U0001F1F5\U0001F1FA\U0001F1F8\U0001F1EC\U0001F1E7", 2, "…"); got != "\U0001F1EF\U0001F1F5…" {
+		t.Errorf("Truncate() flags = %q", got)
+	}
+	if got := Truncate("abcdef", 2, "..."); got != "ab" {
+		t.Errorf("Truncate() no room for ellipsis = %q", got)
+	}
+}
+
+func TestPaddingWideRune(t *testing.T) {
+	if got := PadRight("a", 4, '日'); got != "a日 " || DisplayWidth(got) != 4 {
+		t.Errorf("PadRight() with odd gap = %q", got)
+	}
+	if got := PadCenter("ab", 7, '日'); DisplayWidth(got) != 7 {
+		t.Errorf("PadCenter() width = %d (%q)", DisplayWidth(got), got)
+	}
+}
+
+func TestDisplayWidth(t *testing.T) {
+	cases := map[string]int{
+		"abc":                        3,
+		"日本語":                        6,
+		"ｈｉ":                         4,
+		"e\u0301":                    1,
+		"\U0001F600":                 2,
+		"\U0001F1EF\U0001F1F5":       2,
+		"\u2764\ufe0f":               2,
+		"\U0001F469\u200d\U0001F4BB": 2,
+	}
+	for in, want := range cases {
+		if got := DisplayWidth(in); got != want {
+			t.Errorf("DisplayWidth(%q) = %d, want %d", in, got, want)
+		}
+	}
+}
@@ -0,0 +1,174 @@
+package fnkit
+
+// The ...Indexed functions below are the callback-taking helpers of slice.go with the
+// element's index passed to the callback before the element, as JavaScript's array methods
+// do. They walk the slice directly, so unlike going through Entries nothing is allocated
+// for the index.
+
+// GroupByIndexed is GroupBy with the index passed to 'keyFn'.
+func GroupByIndexed[K any, T comparable](s []K, keyFn func(int, K) T) map[T][]K {
+	result := make(map[T][]K)
+	for i, v := range s {
+		key := keyFn(i, v)
+		result[key] = append(result[key], v)
+	}
+	return result
+}
+
+// MapIndexed is Map with the index passed to 'f'.
+//
+//	fnkit.MapIndexed(rows, func(i int, r string) string { return fmt.Sprintf("%d. %s", i+1, r) })
+func MapIndexed[K any, T any](s []K, f func(int, K) T) []T {
+	result := make([]T, len(s))
+	for i, v := range s {
+		result[i] = f(i, v)
+	}
+	return result
+}
+
+// ReduceIndexed is Reduce with the index passed to 'f' between the accumulator and the element.
+func ReduceIndexed[K any, T any](s []K, initial T, f func(T, int, K) T) T {
+	accumulator := initial
+	for i, v := range s {
+		accumulator = f(accumulator, i, v)
+	}
+	return accumulator
+}
+
+// ReduceRightIndexed is ReduceRight with the index passed to 'f' between the accumulator
+// and the element. Indexes count down from len(s)-1.
+func ReduceRightIndexed[K any, T any](s []K, initial T, f func(T, int, K) T) T {
+	accumulator := initial
+	for i := len(s) - 1; i >= 0; i-- {
+		accumulator = f(accumulator, i, s[i])
+	}
+	return accumulator
+}
+
+// ScanIndexed is Scan with the index passed to 'f' between the accumulator and the element.
+func ScanIndexed[K any, T any](s []K, initial T, f func(T, int, K) T) []T {
+	result := make([]T, len(s))
+	accumulator := initial
+	for i, v := range s {
+		accumulator = f(accumulator, i, v)
+		result[i] = accumulator
+	}
+	return result
+}
+
+// EveryIndexed is Every with the index passed to 'f'.
+func EveryIndexed[K any](s []K, f func(int, K) bool) bool {
+	for i, v := range s {
+		if !f(i, v) {
+			return false
+		}
+	}
+	return true
+}
+
+// AnyIndexed is Any with the index passed to 'f'.
+func AnyIndexed[K any](s []K, f func(int, K) bool) bool {
+	return FindIndexIndexed(s, f) >= 0
+}
+
+// FindIndexed is Find with the index passed to 'f'.
+func FindIndexed[K any](s []K, f func(int, K) bool) (K, bool) {
+	if i := FindIndexIndexed(s, f); i >= 0 {
+		return s[i], true
+	}
+	var zero K
+	return zero, false
+}
+
+// FindIndexIndexed is FindIndex with the index passed to 'f'.
+func FindIndexIndexed[K any](s []K, f func(int, K) bool) int {
+	for i, v := range s {
+		if f(i, v) {
+			return i
+		}
+	}
+	return -1
+}
+
+// FindLastIndexed is FindLast with the index passed to 'f'.
+func FindLastIndexed[K any](s []K, f func(int, K) bool) (K, bool) {
+	if i := FindLastIndexIndexed(s, f); i >= 0 {
+		return s[i], true
+	}
+	var zero K
+	return zero, false
+}
+
+// FindLastIndexIndexed is FindLastIndex with the index passed to 'f'.
+func FindLastIndexIndexed[K any](s []K, f func(int, K) bool) int {
+	for i := len(s) - 1; i >= 0; i-- {
+		if f(i, s[i]) {
+			return i
+		}
+	}
+	return -1
+}
+
+// FlatMapIndexed is FlatMap with the index passed to 'f'.
+func FlatMapIndexed[K any, T any](s []K, f func(int, K) []T) []T {
+	var result []T
+	for i, v := range s {
+		result = append(result, f(i, v)...)
+	}
+	return result
+}
+
+// ForEachIndexed is ForEach with the index passed to 'f'.
+func ForEachIndexed[K any](s []K, f func(int, K)) {
+	for i, v := range s {
+		f(i, v)
+	}
+}
+
+// FilterIndexed is Filter with the index passed to 'f'. It filters the slice in place;
+// the index is the element's position before filtering.
+func FilterIndexed[K any](s *[]K, f func(int, K) bool) {
+	w := 0
+	for i, v := range *s {
+		if f(i, v) {
+			(*s)[w] = v
+			w++
+		}
+	}
+	*s = (*s)[:w]
+}
+
+// ToFilterIndexed is ToFilter with the index passed to 'f'.
+//
+//	data := fnkit.ToFilterIndexed(lines, func(i int, _ string) bool { return i > 0 }) // skip header
+func ToFilterIndexed[K any](s []K, f func(int, K) bool) []K {
+	var result []K
+	for i, v := range s {
+		if f(i, v) {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// PartitionFuncIndexed is PartitionFunc with the index passed to 'f'.
+func PartitionFuncIndexed[K any](s []K, f func(int, K) bool) (yes, no []K) {
+	for i, v := range s {
+		if f(i, v) {
+			yes = append(yes, v)
+		} else {
+			no = append(no, v)
+		}
+	}
+	return yes, no
+}
+
+// AssociateIndexed is Associate with the index passed to 'f'.
+func AssociateIndexed[K any, M comparable, V any](s []K, f func(int, K) (M, V)) map[M]V {
+	result := make(map[M]V, len(s))
+	for i, v := range s {
+		k, val := f(i, v)
+		result[k] = val
+	}
+	return result
+}
@@ -0,0 +1,92 @@
+package fnkit
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestMapReduceIndexed(t *testing.T) {
+	rows := []string{"a", "b", "c"}
+	numbered := MapIndexed(rows, func(i int, r string) string { return fmt.Sprintf("%d.%s", i+1, r) })
+	if !reflect.DeepEqual(numbered, []string{"1.a", "2.b", "3.c"}) {
+		t.Errorf("MapIndexed = %v", numbered)
+	}
+	weighted := ReduceIndexed([]int{5, 5, 5}, 0, func(acc, i, v int) int { return acc + i*v })
+	if weighted != 15 {
+		t.Errorf("ReduceIndexed = %d", weighted)
+	}
+	var order []int
+	ReduceRightIndexed(rows, "", func(acc string, i int, v string) string {
+		order = append(order, i)
+		return acc + v
+	})
+	if !reflect.DeepEqual(order, []int{2, 1, 0}) {
+		t.Errorf("ReduceRightIndexed indexes = %v", order)
+	}
+	if got := ScanIndexed([]int{1, 1, 1}, 0, func(acc, i, v int) int { return acc + i + v }); !reflect.DeepEqual(got, []int{1, 3, 6}) {
+		t.Errorf("ScanIndexed = %v", got)
+	}
+}
+
+func TestPredicatesIndexed(t *testing.T) {
+	s := []int{0, 1, 2, 9, 4}
+	atOwnIndex := func(i, v int) bool { return i == v }
+	if EveryIndexed(s, atOwnIndex) || !EveryIndexed(s[:3], atOwnIndex) {
+		t.Error("EveryIndexed gave the wrong answer")
+	}
+	if !AnyIndexed(s, func(i, v int) bool { return v > i*2 }) || AnyIndexed([]int{}, atOwnIndex) {
+		t.Error("AnyIndexed gave the wrong answer")
+	}
+	if v, ok := FindIndexed(s, func(i, v int) bool { return i != v }); v != 9 || !ok {
+		t.Errorf("FindIndexed = %d, %v", v, ok)
+	}
+	if i := FindIndexIndexed(s, func(i, v int) bool { return i != v }); i != 3 {
+		t.Errorf("FindIndexIndexed = %d", i)
+	}
+	if v, ok := FindLastIndexed(s, atOwnIndex); v != 4 || !ok {
+		t.Errorf("FindLastIndexed = %d, %v", v, ok)
+	}
+	if i := FindLastIndexIndexed(s, func(i, v int) bool { return v > 100 }); i != -1 {
+		t.Errorf("FindLastIndexIndexed = %d", i)
+	}
+	if _, ok := FindLastIndexed(s, func(i, v int) bool { return v > 100 }); ok {
+		t.Error("FindLastIndexed found a missing element")
+	}
+}
+
+func TestFilterIndexed(t *testing.T) {
+	lines := []string{"name,age", "ada,36", "bob,25"}
+	if got := ToFilterIndexed(lines, func(i int, _ string) bool { return i > 0 }); !reflect.DeepEqual(got, lines[1:]) {
+		t.Errorf("ToFilterIndexed = %v", got)
+	}
+	s := []int{10, 11, 12, 13}
+	FilterIndexed(&s, func(i, _ int) bool { return i%2 == 1 })
+	if !reflect.DeepEqual(s, []int{11, 13}) {
+		t.Errorf("FilterIndexed = %v", s)
+	}
+	even, odd := PartitionFuncIndexed([]string{"a", "b", "c"}, func(i int, _ string) bool { return i%2 == 0 })
+	if !reflect.DeepEqual(even, []string{"a", "c"}) || !reflect.DeepEqual(odd, []string{"b"}) {
+		t.Errorf("PartitionFuncIndexed = %v, %v", even, odd)
+	}
+}
+
+func TestOtherIndexed(t *testing.T) {
+	groups := GroupByIndexed([]string{"a", "b", "c", "d"}, func(i int, _ string) int { return i / 2 })
+	if !reflect.DeepEqual(groups, map[int][]string{0: {"a", "b"}, 1: {"c", "d"}}) {
+		t.Errorf("GroupByIndexed = %v", groups)
+	}
+	if got := FlatMapIndexed([]string{"x", "y"}, func(i int, v string) []string { return strings.Split(strings.Repeat(v, i+1), "") }); !reflect.DeepEqual(got, []string{"x", "y", "y"}) {
+		t.Errorf("FlatMapIndexed = %v", got)
+	}
+	positions := AssociateIndexed([]string{"a", "b"}, func(i int, v string) (string, int) { return v, i })
+	if !reflect.DeepEqual(positions, map[string]int{"a": 0, "b": 1}) {
+		t.Errorf("AssociateIndexed = %v", positions)
+	}
+	var seen []string
+	ForEachIndexed([]string{"a", "b"}, func(i int, v string) { seen = append(seen, fmt.Sprint(i, v)) })
+	if !reflect.DeepEqual(seen, []string{"0a", "1b"}) {
+		t.Errorf("ForEachIndexed = %v", seen)
+	}
+}
@@ -0,0 +1,267 @@
+package fnkit
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// ErrMissingKey is returned by InterpolateWith in strict mode when a placeholder refers to
+// a path that does not exist in the data and has no default.
+var ErrMissingKey = errors.New("missing key")
+
+// InterpolateFilter transforms a placeholder value. args holds the comma-separated arguments
+// written after the filter name, as in {name|padcenter:20,*}.
+type InterpolateFilter func(s string, args []string) (string, error)
+
+// InterpolateOptions configures InterpolateWith.
+type InterpolateOptions struct {
+	// Strict makes missing keys, unknown filters and malformed placeholders errors.
+	// Otherwise missing keys render as "" and malformed placeholders are left as written.
+	Strict bool
+	// Filters adds filters, or replaces built-in filters of the same name.
+	Filters map[string]InterpolateFilter
+}
+
+// Interpolate replaces {placeholders} in tmpl with values from data, leniently:
+//
+//	fnkit.Interpolate("Hello {user.name|capitalize}, you have {count} items", data)
+//
+// A placeholder is a dotted path followed by optional filters separated by "|". Paths walk
+// map keys, exported struct fields (or their json tag names) and slice indexes, through
+// pointers and interfaces. Filters take comma-separated arguments after a colon, e.g.
+// {title|truncate:20,...}. {name|default:Guest} renders "Guest" when name is missing or
+// empty. Write {{ and }} for literal braces.
+//
+// Built-in filters: upper, lower, trim, capitalize, camel, pascal, snake, kebab, constant,
+// title, slug, reverse, padleft, padright, padcenter (width[,char]), truncate (n[,ellipsis])
+// and default.
+func Interpolate(tmpl string, data any) string {
+	out, _ := InterpolateWith(tmpl, data, InterpolateOptions{})
+	return out
+}
+
+// InterpolateWith is Interpolate with options. In strict mode it returns the first error
+// encountered, wrapping ErrMissingKey for missing keys.
+func InterpolateWith(tmpl string, data any, opts InterpolateOptions) (string, error) {
+	var b strings.Builder
+	for i := 0; i < len(tmpl); {
+		c := tmpl[i]
+		switch {
+		case c == '{' && strings.HasPrefix(tmpl[i:], "{{"):
+			b.WriteByte('{')
+			i += 2
+		case c == '}' && strings.HasPrefix(tmpl[i:], "}}"):
+			b.WriteByte('}')
+			i += 2
+		case c == '{':
+			end := strings.IndexByte(tmpl[i:], '}')
+			if end < 0 {
+				if opts.Strict {
+					return "", fmt.Errorf("interpolate: unterminated placeholder at byte %d", i)
+				}
+				b.WriteString(tmpl[i:])
+				return b.String(), nil
+			}
+			expr := tmpl[i+1 : i+end]
+			s, err := interpolateExpr(expr, data, opts)
+			if err != nil {
+				if opts.Strict {
+					return "", err
+				}
+				s = tmpl[i : i+end+1]
+			}
+			b.WriteString(s)
+			i += end + 1
+		default:
+			b.WriteByte(c)
+			i++
+		}
+	}
+	return b.String(), nil
+}
+
+// interpolateExpr evaluates the contents of a single placeholder.
+func interpolateExpr(expr string, data any, opts InterpolateOptions) (string, error) {
+	parts := strings.Split(expr, "|")
+	path := strings.TrimSpace(parts[0])
+	if path == "" {
+		return "", fmt.Errorf("interpolate: empty placeholder {%s}", expr)
+	}
+	value, found := resolvePath(data, path)
+	s := ""
+	if found {
+		s = fmt.Sprint(value)
+	}
+	hasDefault := false
+	for _, p := range parts[1:] {
+		name, rawArgs, _ := strings.Cut(strings.TrimSpace(p), ":")
+		var args []string
+		if rawArgs != "" {
+			args = strings.Split(rawArgs, ",")
+		}
+		if name == "default" {
+			hasDefault = true
+			if !found || s == "" {
+				s = strings.Join(args, ",")
+			}
+			continue
+		}
+		f, ok := opts.Filters[name]
+		if !ok {
+			f, ok = interpolateFilters[name]
+		}
+		if !ok {
+			return "", fmt.Errorf("interpolate: unknown filter %q in {%s}", name, expr)
+		}
+		var err error
+		if s, err = f(s, args); err != nil {
+			return "", fmt.Errorf("interpolate: filter %q in {%s}: %w", name, expr, err)
+		}
+	}
+	if !found && !hasDefault && opts.Strict {
+		return "", fmt.Errorf("interpolate: %q: %w", path, ErrMissingKey)
+	}
+	return s, nil
+}
+
+// resolvePath looks up a dotted path such as "user.addresses.0.city" in v. Each segment
+// selects a map key, an exported struct field (by name or json tag) or a slice or array
+// index; pointers and interfaces are followed. It returns false if any segment is missing.
+func resolvePath(v any, path string) (any, bool) {
+	cur := reflect.ValueOf(v)
+	for _, seg := range strings.Split(path, ".") {
+		cur = indirect(cur)
+		if !cur.IsValid() {
+			return nil, false
+		}
+		switch cur.Kind() {
+		case reflect.Map:
+			if cur.Type().Key().Kind() != reflect.String {
+				return nil, false
+			}
+			next := cur.MapIndex(reflect.ValueOf(seg).Convert(cur.Type().Key()))
+			if !next.IsValid() {
+				return nil, false
+			}
+			cur = next
+		case reflect.Struct:
+			f, ok := structField(cur, seg)
+			if !ok {
+				return nil, false
+			}
+			cur = f
+		case reflect.Slice, reflect.Array:
+			i, err := strconv.Atoi(seg)
+			if err != nil || i < 0 || i >= cur.Len() {
+				return nil, false
+			}
+			cur = cur.Index(i)
+		default:
+			return nil, false
+		}
+	}
+	cur = indirect(cur)
+	if !cur.IsValid() || !cur.CanInterface() {
+		return nil, false
+	}
+	return cur.Interface(), true
+}
+
+// indirect follows pointers and interfaces, returning the zero Value for nil.
+func indirect(v reflect.Value) reflect.Value {
+	for v.IsValid() && (v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+// structField returns the exported field of v named name, or whose json tag is name.
+// A field promoted through a nil embedded pointer is reported as missing.
+func structField(v reflect.Value, name string) (reflect.Value, bool) {
+	t := v.Type()
+	if f, ok := t.FieldByName(name); ok && f.IsExported() {
+		fv, err := v.FieldByIndexErr(f.Index)
+		return fv, err == nil
+	}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		if tag, _, _ := strings.Cut(f.Tag.Get("json"), ","); tag == name {
+			return v.Field(i), true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+// interpolateFilters are the built-in filters available to Interpolate.
+var interpolateFilters = map[string]InterpolateFilter{
+	"upper":      stringFilter(strings.ToUpper),
+	"lower":      stringFilter(strings.ToLower),
+	"trim":       stringFilter(strings.TrimSpace),
+	"capitalize": stringFilter(Capitalize),
+	"camel":      stringFilter(CamelCase),
+	"pascal":     stringFilter(PascalCase),
+	"snake":      stringFilter(SnakeCase),
+	"kebab":      stringFilter(KebabCase),
+	"constant":   stringFilter(ConstantCase),
+	"title":      stringFilter(TitleCase),
+	"reverse":    stringFilter(ReverseString),
+	"slug": stringFilter(func(s string) string {
+		return Slugify(s, SlugOptions{})
+	}),
+	"padleft":   padFilter(PadLeft),
+	"padright":  padFilter(PadRight),
+	"padcenter": padFilter(PadCenter),
+	"truncate": func(s string, args []string) (string, error) {
+		if len(args) == 0 || len(args) > 2 {
+			return "", errors.New("want n[,ellipsis]")
+		}
+		n, err := strconv.Atoi(strings.TrimSpace(args[0]))
+		if err != nil {
+			return "", err
+		}
+		ellipsis := ""
+		if len(args) == 2 {
+			ellipsis = args[1]
+		}
+		return Truncate(s, n, ellipsis), nil
+	},
+}
+
+func stringFilter(f func(string) string) InterpolateFilter {
+	return func(s string, args []string) (string, error) {
+		if len(args) > 0 {
+			return "", errors.New("takes no arguments")
+		}
+		return f(s), nil
+	}
+}
+
+func padFilter(pad func(string, int, rune) string) InterpolateFilter {
+	return func(s string, args []string) (string, error) {
+		if len(args) == 0 || len(args) > 2 {
+			return "", errors.New("want width[,char]")
+		}
+		width, err := strconv.Atoi(strings.TrimSpace(args[0]))
+		if err != nil {
+			return "", err
+		}
+		padChar := ' '
+		if len(args) == 2 {
+			r := []rune(args[1])
+			if len(r) != 1 {
+				return "", fmt.Errorf("pad character %q must be a single rune", args[1])
+			}
+			padChar = r[0]
+		}
+		return pad(s, width, padChar), nil
+	}
+}
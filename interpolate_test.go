@@ -0,0 +1,97 @@
+package fnkit
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+type interpolateUser struct {
+	Name    string
+	Email   string `json:"email"`
+	Tags    []string
+	Manager *interpolateUser
+	secret  string
+}
+
+func TestInterpolate(t *testing.T) {
+	data := map[string]any{
+		"user": interpolateUser{
+			Name:    "ada lovelace",
+			Email:   "ada@example.com",
+			Tags:    []string{"admin", "ops"},
+			Manager: &interpolateUser{Name: "Charles"},
+			secret:  "hidden",
+		},
+		"count": 3,
+		"title": "Hello World",
+	}
+	cases := map[string]string{
+		"Hello {user.Name|capitalize}, you have {count} items": "Hello Ada lovelace, you have 3 items",
+		"{user.email}":                             "ada@example.com",
+		"{user.Tags.1|upper}":                      "OPS",
+		"{user.Manager.Name}":                      "Charles",
+		"{title|snake}":                            "hello_world",
+		"[{title|padcenter:15,*}]":                 "[**Hello World**]",
+		"{title|truncate:8,...}":                   "Hello...",
+		"{missing|default:Guest}":                  "Guest",
+		"{user.Manager.Manager.Name|default:none}": "none",
+		"{{literal}} {count}":                      "{literal} 3",
+		"{missing}!":                               "!",
+		"{user.secret}":                            "",
+		"{title|nosuchfilter}":                     "{title|nosuchfilter}",
+		"unterminated {count":                      "unterminated {count",
+	}
+	for tmpl, want := range cases {
+		if got := Interpolate(tmpl, data); got != want {
+			t.Errorf("Interpolate(%q) = %q, want %q", tmpl, got, want)
+		}
+	}
+}
+
+func TestInterpolateNilEmbedded(t *testing.T) {
+	type inner struct{ City string }
+	data := struct {
+		*inner
+		Name string
+	}{Name: "x"}
+	if got := Interpolate("{Name} {City|default:none}", data); got != "x none" {
+		t.Errorf("Interpolate() through nil embedded pointer = %q", got)
+	}
+	data.inner = &inner{City: "Paris"}
+	if got := Interpolate("{Name} {City|default:none}", data); got != "x Paris" {
+		t.Errorf("Interpolate() through embedded pointer = %q", got)
+	}
+}
+
+func TestInterpolateStrict(t *testing.T) {
+	data := map[string]any{"name": "ada"}
+	strict := InterpolateOptions{Strict: true}
+	if _, err := InterpolateWith("hi {nme}", data, strict); !errors.Is(err, ErrMissingKey) {
+		t.Errorf("expected ErrMissingKey, got %v", err)
+	}
+	if got, err := InterpolateWith("hi {nme|default:you}", data, strict); err != nil || got != "hi you" {
+		t.Errorf("default in strict mode = %q, %v", got, err)
+	}
+	if _, err := InterpolateWith("{name|shout}", data, strict); err == nil {
+		t.Error("expected unknown filter error")
+	}
+	if _, err := InterpolateWith("{name|padleft:x}", data, strict); err == nil {
+		t.Error("expected bad argument error")
+	}
+	if _, err := InterpolateWith("{name", data, strict); err == nil {
+		t.Error("expected unterminated placeholder error")
+	}
+}
+
+func TestInterpolateCustomFilter(t *testing.T) {
+	opts := InterpolateOptions{Filters: map[string]InterpolateFilter{
+		"repeat": func(s string, args []string) (string, error) {
+			return strings.Repeat(s, len(args)+1), nil
+		},
+	}}
+	got, err := InterpolateWith("{word|repeat:x,y}", map[string]string{"word": "ab"}, opts)
+	if err != nil || got != "ababab" {
+		t.Errorf("custom filter = %q, %v", got, err)
+	}
+}
@@ -0,0 +1,272 @@
+package fnkit
+
+import (
+	"strings"
+	"unicode"
+)
+
+// WrapOptions configures WrapWith.
+type WrapOptions struct {
+	// HardBreak splits words wider than the line width across lines. Otherwise such words
+	// are placed on a line of their own and overflow it.
+	HardBreak bool
+}
+
+// Wrap word-wraps s so no line is wider than width display columns. Existing line breaks
+// are kept and runs of spaces between words collapse to one. ANSI escape sequences, such
+// as terminal colors, take no width and are never split.
+func Wrap(s string, width int) string {
+	return WrapWith(s, width, WrapOptions{})
+}
+
+// WrapWith is Wrap with options.
+func WrapWith(s string, width int, opts WrapOptions) string {
+	if width <= 0 {
+		return s
+	}
+	lines := strings.Split(s, "\n")
+	out := make([]string, 0, len(lines))
+	for _, line := range lines {
+		out = append(out, wrapLine(line, width, opts)...)
+	}
+	return strings.Join(out, "\n")
+}
+
+func wrapLine(line string, width int, opts WrapOptions) []string {
+	var out []string
+	var cur strings.Builder
+	curWidth := 0
+	for _, word := range strings.Fields(line) {
+		w := visibleWidth(word)
+		if curWidth > 0 && curWidth+1+w <= width {
+			cur.WriteByte(' ')
+			cur.WriteString(word)
+			curWidth += 1 + w
+			continue
+		}
+		if curWidth > 0 {
+			out = append(out, cur.String())
+			cur.Reset()
+			curWidth = 0
+		}
+		if w > width && opts.HardBreak {
+			chunks := splitVisible(word, width)
+			out = append(out, chunks[:len(chunks)-1]...)
+			word = chunks[len(chunks)-1]
+			w = visibleWidth(word)
+		}
+		cur.WriteString(word)
+		curWidth = w
+	}
+	return append(out, cur.String())
+}
+
+// splitVisible cuts s into pieces at most width columns wide, keeping grapheme clusters
+// and ANSI escape sequences whole.
+func splitVisible(s string, width int) []string {
+	var chunks []string
+	start, chunkWidth := 0, 0
+	for i := 0; i < len(s); {
+		if n := ansiLen(s[i:]); n > 0 {
+			i += n
+			continue
+		}
+		n := graphemeLen(s[i:])
+		w := clusterWidth(s[i : i+n])
+		if chunkWidth > 0 && chunkWidth+w > width {
+			chunks = append(chunks, s[start:i])
+			start, chunkWidth = i, 0
+		}
+		chunkWidth += w
+		i += n
+	}
+	return append(chunks, s[start:])
+}
+
+// StripANSI removes ANSI escape sequences (colors, cursor movement, hyperlinks) from s.
+func StripANSI(s string) string {
+	if !strings.Contains(s, "\x1b") {
+		return s
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); {
+		if n := ansiLen(s[i:]); n > 0 {
+			i += n
+			continue
+		}
+		b.WriteByte(s[i])
+		i++
+	}
+	return b.String()
+}
+
+// visibleWidth is DisplayWidth ignoring ANSI escape sequences.
+func visibleWidth(s string) int {
+	return DisplayWidth(StripANSI(s))
+}
+
+// ansiLen returns the length of the ANSI escape sequence at the start of s, or 0.
+func ansiLen(s string) int {
+	if len(s) < 2 || s[0] != '\x1b' {
+		return 0
+	}
+	switch s[1] {
+	case '[': // CSI: parameters, intermediates, final byte
+		for i := 2; i < len(s); i++ {
+			if s[i] >= 0x40 && s[i] <= 0x7E {
+				return i + 1
+			}
+		}
+		return len(s)
+	case ']': // OSC: terminated by BEL or ESC \
+		for i := 2; i < len(s); i++ {
+			if s[i] == '\a' {
+				return i + 1
+			}
+			if s[i] == '\x1b' && i+1 < len(s) && s[i+1] == '\\' {
+				return i + 2
+			}
+		}
+		return len(s)
+	}
+	return 2
+}
+
+// Indent adds prefix to the start of every non-blank line of s.
+func Indent(s, prefix string) string {
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		if strings.TrimSpace(line) != "" {
+			lines[i] = prefix + line
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Dedent removes the longest common leading whitespace from every line of s, so that
+// indented raw string literals can be written in line with the surrounding code.
+// Whitespace-only lines are ignored when finding the common prefix and emptied.
+func Dedent(s string) string {
+	lines := strings.Split(s, "\n")
+	var margin string
+	first := true
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		indent := line[:len(line)-len(strings.TrimLeftFunc(line, unicode.IsSpace))]
+		if first {
+			margin, first = indent, false
+			continue
+		}
+		for !strings.HasPrefix(indent, margin) {
+			margin = margin[:len(margin)-1]
+		}
+	}
+	for i, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			lines[i] = ""
+		} else {
+			lines[i] = line[len(margin):]
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Center centers every line of s within width display columns by padding it on the left
+// with spaces. Lines already at least width wide are left unchanged.
+func Center(s string, width int) string {
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		if pad := width - visibleWidth(line); pad > 1 && line != "" {
+			lines[i] = strings.Repeat(" ", pad/2) + line
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Columns lays rows out as an aligned text table, padding every cell but the last in each
+// row to the width of its column and joining cells with sep. Widths are measured in display
+// columns and ignore ANSI escape sequences. Rows may have different numbers of cells.
+//
+//	fnkit.Columns([][]string{{"NAME", "AGE"}, {"Ada", "36"}}, "  ")
+//	// NAME  AGE
+//	// Ada   36
+func Columns(rows [][]string, sep string) string {
+	var widths []int
+	for _, row := range rows {
+		for i, cell := range row {
+			if i == len(widths) {
+				widths = append(widths, 0)
+			}
+			widths[i] = max(widths[i], visibleWidth(cell))
+		}
+	}
+	lines := make([]string, len(rows))
+	for r, row := range rows {
+		var b strings.Builder
+		for i, cell := range row {
+			if i > 0 {
+				b.WriteString(sep)
+			}
+			b.WriteString(cell)
+			if i < len(row)-1 {
+				b.WriteString(strings.Repeat(" ", widths[i]-visibleWidth(cell)))
+			}
+		}
+		lines[r] = b.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// EllipsizeMode selects where Ellipsize removes text.
+type EllipsizeMode int
+
+const (
+	EllipsizeEnd    EllipsizeMode = iota // "a long sent…"
+	EllipsizeMiddle                      // "a long…ntence"
+	EllipsizeStart                       // "…ong sentence"
+)
+
+// Ellipsize shortens s to at most width display columns, replacing the removed text with
+// "…" at the end, in the middle or at the start. Grapheme clusters are never split.
+func Ellipsize(s string, width int, mode EllipsizeMode) string {
+	if DisplayWidth(s) <= width {
+		return s
+	}
+	if width <= 0 {
+		return ""
+	}
+	const ellipsis = "…"
+	clusters := Graphemes(s)
+	budget := width - 1
+	var head, tail int // number of clusters kept from the front and back
+	switch mode {
+	case EllipsizeStart:
+		tail = fitClusters(clusters, budget, true)
+	case EllipsizeMiddle:
+		head = fitClusters(clusters, budget-budget/2, false)
+		tail = fitClusters(clusters[head:], budget/2, true)
+	default:
+		head = fitClusters(clusters, budget, false)
+	}
+	return strings.Join(clusters[:head], "") + ellipsis + strings.Join(clusters[len(clusters)-tail:], "")
+}
+
+// fitClusters counts how many clusters from the front (or back) of clusters fit in width columns.
+func fitClusters(clusters []string, width int, fromBack bool) int {
+	used, n := 0, 0
+	for n < len(clusters) {
+		c := clusters[n]
+		if fromBack {
+			c = clusters[len(clusters)-1-n]
+		}
+		w := clusterWidth(c)
+		if used+w > width {
+			break
+		}
+		used += w
+		n++
+	}
+	return n
+}
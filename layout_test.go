@@ -0,0 +1,87 @@
+package fnkit
+
+import "testing"
+
+func TestWrap(t *testing.T) {
+	in := "The quick brown fox jumps over the lazy dog"
+	want := "The quick\nbrown fox\njumps over\nthe lazy\ndog"
+	if got := Wrap(in, 10); got != want {
+		t.Errorf("Wrap() = %q, want %q", got, want)
+	}
+	if got := Wrap("first para\n\nsecond", 20); got != "first para\n\nsecond" {
+		t.Errorf("Wrap() keeps newlines = %q", got)
+	}
+	if got := Wrap("see https://example.com/a/long/path ok", 10); got != "see\nhttps://example.com/a/long/path\nok" {
+		t.Errorf("Wrap() long word = %q", got)
+	}
+	if got := WrapWith("abcdefghij xy", 4, WrapOptions{HardBreak: true}); got != "abcd\nefgh\nij\nxy" {
+		t.Errorf("WrapWith() hard break = %q", got)
+	}
+	red := "\x1b[31mred\x1b[0m"
+	if got := Wrap(red+" "+red+" "+red, 7); got != red+" "+red+"\n"+red {
+		t.Errorf("Wrap() ANSI = %q", got)
+	}
+	if got := Wrap("日本語 テキスト", 8); got != "日本語\nテキスト" {
+		t.Errorf("Wrap() wide = %q", got)
+	}
+}
+
+func TestStripANSI(t *testing.T) {
+	if got := StripANSI("\x1b[1;32mok\x1b[0m \x1b]8;;https://x\x07link\x1b]8;;\x07"); got != "ok link" {
+		t.Errorf("StripANSI() = %q", got)
+	}
+}
+
+func TestIndentDedent(t *testing.T) {
+	if got := Indent("a\n\nb", "  "); got != "  a\n\n  b" {
+		t.Errorf("Indent() = %q", got)
+	}
+	in := "\n    def f():\n        return 1\n  \n    f()"
+	if got := Dedent(in); got != "\ndef f():\n    return 1\n\nf()" {
+		t.Errorf("Dedent() = %q", got)
+	}
+	if got := Dedent("\t\ta\n\t b"); got != "\ta\n b" {
+		t.Errorf("Dedent() mixed = %q", got)
+	}
+}
+
+func TestCenter(t *testing.T) {
+	if got := Center("hi\nhello\n", 9); got != "   hi\n  hello\n" {
+		t.Errorf("Center() = %q", got)
+	}
+}
+
+func TestColumns(t *testing.T) {
+	rows := [][]string{
+		{"NAME", "AGE", "CITY"},
+		{"Ada", "36", "London"},
+		{"\x1b[1mGrace\x1b[0m", "85"},
+		{"名前", "1", "東京"},
+	}
+	want := "NAME   AGE  CITY\n" +
+		"Ada    36   London\n" +
+		"\x1b[1mGrace\x1b[0m  85\n" +
+		"名前   1    東京"
+	if got := Columns(rows, "  "); got != want {
+		t.Errorf("Columns() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestEllipsize(t *testing.T) {
+	s := "a long sentence"
+	if got := Ellipsize(s, 10, EllipsizeEnd); got != "a long se…" {
+		t.Errorf("Ellipsize(end) = %q", got)
+	}
+	if got := Ellipsize(s, 10, EllipsizeMiddle); got != "a lon…ence" {
+		t.Errorf("Ellipsize(middle) = %q", got)
+	}
+	if got := Ellipsize(s, 10, EllipsizeStart); got != "… sentence" {
+		t.Errorf("Ellipsize(start) = %q", got)
+	}
+	if got := Ellipsize("short", 10, EllipsizeMiddle); got != "short" {
+		t.Errorf("Ellipsize() no-op = %q", got)
+	}
+	if got := Ellipsize("日本語テキスト", 7, EllipsizeEnd); got != "日本語…" {
+		t.Errorf("Ellipsize() wide = %q", got)
+	}
+}
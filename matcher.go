@@ -0,0 +1,214 @@
+package fnkit
+
+import (
+	"sort"
+	"strings"
+)
+
+// Matcher searches a string for many patterns at once using the Aho-Corasick algorithm.
+// Building it costs time proportional to the total length of the patterns; after that, each
+// search scans the input once regardless of how many patterns there are. A Matcher is
+// immutable and safe for concurrent use.
+type Matcher struct {
+	patterns []string
+	nodes    []acNode
+	root     [256]int32 // dense transitions out of the root
+}
+
+// acNode is a trie node. keys and next hold its outgoing edges.
+type acNode struct {
+	keys  []byte
+	next  []int32
+	fail  int32 // longest proper suffix of this node that is also in the trie
+	dict  int32 // nearest node along fail links that ends a pattern, or -1
+	depth int32 // length of the prefix this node stands for
+	out   []int // indexes of the patterns ending at this node
+}
+
+// Occurrence is a pattern found by a Matcher. s[Start:End] == Pattern.
+type Occurrence struct {
+	Pattern string
+	Index   int // position of Pattern in the list given to NewMatcher
+	Start   int // byte offset of the first byte of the match
+	End     int // byte offset just past the match
+}
+
+// NewMatcher compiles patterns into a Matcher. Empty patterns are ignored.
+func NewMatcher(patterns ...string) *Matcher {
+	m := &Matcher{patterns: patterns, nodes: []acNode{{dict: -1}}}
+	for i, p := range patterns {
+		if p == "" {
+			continue
+		}
+		n := int32(0)
+		for j := 0; j < len(p); j++ {
+			child, ok := m.child(n, p[j])
+			if !ok {
+				child = int32(len(m.nodes))
+				m.nodes = append(m.nodes, acNode{dict: -1, depth: int32(j + 1)})
+				m.nodes[n].keys = append(m.nodes[n].keys, p[j])
+				m.nodes[n].next = append(m.nodes[n].next, child)
+			}
+			n = child
+		}
+		m.nodes[n].out = append(m.nodes[n].out, i)
+	}
+	for k, c := range m.nodes[0].keys {
+		m.root[c] = m.nodes[0].next[k]
+	}
+	queue := append([]int32(nil), m.nodes[0].next...)
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+		for k, c := range m.nodes[n].keys {
+			child := m.nodes[n].next[k]
+			fail := int32(0)
+			if n != 0 {
+				fail = m.step(m.nodes[n].fail, c)
+			}
+			m.nodes[child].fail = fail
+			if len(m.nodes[fail].out) > 0 {
+				m.nodes[child].dict = fail
+			} else {
+				m.nodes[child].dict = m.nodes[fail].dict
+			}
+			queue = append(queue, child)
+		}
+	}
+	return m
+}
+
+// Patterns returns the patterns the Matcher was built from.
+func (m *Matcher) Patterns() []string {
+	return m.patterns
+}
+
+func (m *Matcher) child(n int32, c byte) (int32, bool) {
+	for k, key := range m.nodes[n].keys {
+		if key == c {
+			return m.nodes[n].next[k], true
+		}
+	}
+	return 0, false
+}
+
+// step returns the state reached from n on byte c, following fail links as needed.
+func (m *Matcher) step(n int32, c byte) int32 {
+	for n != 0 {
+		if child, ok := m.child(n, c); ok {
+			return child
+		}
+		n = m.nodes[n].fail
+	}
+	return m.root[c]
+}
+
+// scan calls yield for every occurrence of every pattern in s, in order of end position,
+// until yield returns false.
+func (m *Matcher) scan(s string, yield func(Occurrence) bool) {
+	n := int32(0)
+	for i := 0; i < len(s); i++ {
+		n = m.step(n, s[i])
+		for o := n; o > 0; o = m.nodes[o].dict {
+			for _, p := range m.nodes[o].out {
+				pat := m.patterns[p]
+				if !yield(Occurrence{Pattern: pat, Index: p, Start: i + 1 - len(pat), End: i + 1}) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// FindAll returns every occurrence of every pattern in s, including overlapping ones,
+// ordered by start offset with longer matches first.
+func (m *Matcher) FindAll(s string) []Occurrence {
+	var found []Occurrence
+	m.scan(s, func(o Occurrence) bool {
+		found = append(found, o)
+		return true
+	})
+	sort.SliceStable(found, func(i, j int) bool {
+		if found[i].Start != found[j].Start {
+			return found[i].Start < found[j].Start
+		}
+		return found[i].End > found[j].End
+	})
+	return found
+}
+
+// ContainsAny reports whether s contains at least one of the patterns. It stops at the
+// first match.
+func (m *Matcher) ContainsAny(s string) bool {
+	found := false
+	m.scan(s, func(Occurrence) bool {
+		found = true
+		return false
+	})
+	return found
+}
+
+// Replace returns a copy of s in which non-overlapping occurrences are replaced by
+// replace(occurrence). Where matches overlap, the leftmost one wins, and among those
+// starting at the same offset the longest. Replacements are written as the scan goes:
+// memory use does not depend on the number of (overlapping) matches, and after each
+// replacement the scan backs up by less than the length of the longest pattern.
+func (m *Matcher) Replace(s string, replace func(Occurrence) string) string {
+	var b strings.Builder
+	last := 0
+	var best Occurrence // leftmost-longest match not yet written; valid while pending
+	pending := false
+	n := int32(0)
+	for i := 0; ; {
+		if i < len(s) {
+			n = m.step(n, s[i])
+			i++
+			// The longest match ending here is the leftmost one; compare it with best.
+			o := n
+			if len(m.nodes[o].out) == 0 {
+				o = m.nodes[o].dict
+			}
+			if o > 0 {
+				p := m.nodes[o].out[0]
+				start := i - len(m.patterns[p])
+				if !pending || start <= best.Start {
+					best = Occurrence{Pattern: m.patterns[p], Index: p, Start: start, End: i}
+					pending = true
+				}
+			}
+			// Any later match starts at i-depth or after; until then best may still lose.
+			if !pending || i-int(m.nodes[n].depth) <= best.Start {
+				continue
+			}
+		} else if !pending {
+			break
+		}
+		b.WriteString(s[last:best.Start])
+		b.WriteString(replace(best))
+		last = best.End
+		// Matches inside s[best.End:i] were passed over while best was pending; rescan them.
+		i, n, pending = best.End, 0, false
+	}
+	if last == 0 {
+		return s
+	}
+	b.WriteString(s[last:])
+	return b.String()
+}
+
+// ReplaceMany replaces every key of replacements found in s with its value in a single
+// pass, using leftmost-longest matching, so replaced text is never matched again:
+//
+//	fnkit.ReplaceMany("cat and category", map[string]string{"cat": "dog", "category": "class"})
+//	// "dog and class"
+//
+// To apply the same replacements to many strings, build a Matcher once and use Replace.
+func ReplaceMany(s string, replacements map[string]string) string {
+	keys := make([]string, 0, len(replacements))
+	for k := range replacements {
+		keys = append(keys, k)
+	}
+	return NewMatcher(keys...).Replace(s, func(o Occurrence) string {
+		return replacements[o.Pattern]
+	})
+}
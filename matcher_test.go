@@ -0,0 +1,117 @@
+package fnkit
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMatcherFindAll(t *testing.T) {
+	m := NewMatcher("he", "she", "his", "hers", "")
+	got := m.FindAll("ushers")
+	want := []Occurrence{
+		{Pattern: "she", Index: 1, Start: 1, End: 4},
+		{Pattern: "hers", Index: 3, Start: 2, End: 6},
+		{Pattern: "he", Index: 0, Start: 2, End: 4},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("FindAll() = %+v, want %+v", got, want)
+	}
+	if got := m.FindAll("nothing here? no"); len(got) != 1 || got[0].Pattern != "he" {
+		t.Errorf("FindAll() = %+v", got)
+	}
+	if got := NewMatcher().FindAll("abc"); got != nil {
+		t.Errorf("FindAll() with no patterns = %+v", got)
+	}
+}
+
+func TestMatcherContainsAny(t *testing.T) {
+	m := NewMatcher("ERROR", "FATAL", "panic:")
+	if !m.ContainsAny("2025-10-02 FATAL disk full") {
+		t.Error("ContainsAny() expected match")
+	}
+	if m.ContainsAny("2025-10-02 INFO all good") {
+		t.Error("ContainsAny() unexpected match")
+	}
+}
+
+func TestMatcherUnicode(t *testing.T) {
+	m := NewMatcher("日本", "本語", "ü")
+	got := m.FindAll("日本語 über")
+	if len(got) != 3 || got[0].Start != 0 || got[1].Start != 3 || got[2].Pattern != "ü" {
+		t.Errorf("FindAll() unicode = %+v", got)
+	}
+}
+
+func TestReplaceMany(t *testing.T) {
+	repl := map[string]string{"cat": "dog", "category": "class", "a": "A"}
+	if got := ReplaceMany("cat and category", repl); got != "dog And class" {
+		t.Errorf("ReplaceMany() = %q", got)
+	}
+	// Replacements are not re-scanned, unlike chained strings.ReplaceAll calls.
+	swap := map[string]string{"x": "y", "y": "x"}
+	if got := ReplaceMany("xxyy", swap); got != "yyxx" {
+		t.Errorf("ReplaceMany() swap = %q", got)
+	}
+	if got := ReplaceMany("untouched", swap); got != "untouched" {
+		t.Errorf("ReplaceMany() no match = %q", got)
+	}
+	secrets := NewMatcher("hunter2", "s3cr3t")
+	masked := secrets.Replace("pw=hunter2 key=s3cr3t", func(o Occurrence) string { return "***" })
+	if masked != "pw=*** key=***" {
+		t.Errorf("Replace() = %q", masked)
+	}
+}
+
+// naiveReplace is the reference leftmost-longest replacement used to check Replace.
+func naiveReplace(s string, patterns []string) string {
+	var b []byte
+	for i := 0; i < len(s); {
+		best := ""
+		for _, p := range patterns {
+			if p != "" && len(p) > len(best) && len(s)-i >= len(p) && s[i:i+len(p)] == p {
+				best = p
+			}
+		}
+		if best == "" {
+			b = append(b, s[i])
+			i++
+			continue
+		}
+		b = append(b, '<')
+		b = append(b, best...)
+		b = append(b, '>')
+		i += len(best)
+	}
+	return string(b)
+}
+
+func TestMatcherReplaceLeftmostLongest(t *testing.T) {
+	pattern := func(o Occurrence) string { return "<" + o.Pattern + ">" }
+	sets := [][]string{
+		{"a", "aa", "aaa"},
+		{"ab", "cd", "abcdef"},
+		{"bc", "abcd", "b", "cdx"},
+		{"he", "she", "his", "hers"},
+		{"aab", "ab", "b", "abab"},
+	}
+	inputs := []string{"", "a", "aaaaaaa", "abcdx", "abcdefab", "xabcdx", "ushers", "aabababb", "babab"}
+	for _, set := range sets {
+		m := NewMatcher(set...)
+		for _, in := range inputs {
+			if got, want := m.Replace(in, pattern), naiveReplace(in, set); got != want {
+				t.Errorf("NewMatcher(%q).Replace(%q) = %q, want %q", set, in, got, want)
+			}
+		}
+	}
+	// Exhaustively check short strings over a small alphabet.
+	m := NewMatcher(sets[4]...)
+	for n := 0; n < 1<<10; n++ {
+		var in []byte
+		for k := 0; k < 10; k++ {
+			in = append(in, "ab"[n>>k&1])
+		}
+		if got, want := m.Replace(string(in), pattern), naiveReplace(string(in), sets[4]); got != want {
+			t.Fatalf("Replace(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
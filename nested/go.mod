@@ -0,0 +1,14 @@
+module github.com/kishankumarhs/fnkit/nested
+
+go 1.23.2
+
+require (
+	github.com/kishankumarhs/fnkit v0.0.0
+	github.com/kishankumarhs/fnkit/validations v0.0.0
+)
+
+require golang.org/x/text v0.22.0 // indirect
+
+replace github.com/kishankumarhs/fnkit => ../
+
+replace github.com/kishankumarhs/fnkit/validations => ../validations
@@ -0,0 +1,530 @@
+package nested
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/kishankumarhs/fnkit"
+	"github.com/kishankumarhs/fnkit/validations"
+)
+
+// GetPath returns the value at 'path' in 'obj', or None if the path does not exist or
+// cannot be parsed. A key that is present with a nil value, like a JSON null, gives
+// Some(nil).
+//
+//	city := nested.GetPath(doc, "users[0].address.city")
+func GetPath(obj any, path string) fnkit.Option[any] {
+	segs, err := parsePath(path)
+	if err != nil {
+		return fnkit.None[any]()
+	}
+	v, ok := get(reflect.ValueOf(obj), segs)
+	if !ok {
+		return fnkit.None[any]()
+	}
+	return fnkit.Some(v)
+}
+
+// HasPath reports whether 'path' exists in 'obj'.
+func HasPath(obj any, path string) bool {
+	return GetPath(obj, path).IsSome()
+}
+
+// GetAs returns the value at 'path' in 'obj' if it exists and has type T.
+func GetAs[T any](obj any, path string) fnkit.Option[T] {
+	v := GetPath(obj, path)
+	if v.IsNone() {
+		return fnkit.None[T]()
+	}
+	t, ok := v.Unwrap().(T)
+	return fnkit.OptionOf(t, ok)
+}
+
+// GetInt returns the value at 'path' in 'obj' converted with validations.ToInt, so numbers
+// of any type and numeric strings are accepted. It returns None if the path does not exist
+// or the value does not convert.
+func GetInt(obj any, path string) fnkit.Option[int] {
+	v := GetPath(obj, path)
+	if v.IsNone() {
+		return fnkit.None[int]()
+	}
+	return fnkit.OptionOf(validations.ToInt(v.Unwrap()))
+}
+
+// GetFloat64 returns the value at 'path' in 'obj' converted with validations.ToFloat64.
+func GetFloat64(obj any, path string) fnkit.Option[float64] {
+	v := GetPath(obj, path)
+	if v.IsNone() {
+		return fnkit.None[float64]()
+	}
+	return fnkit.OptionOf(validations.ToFloat64(v.Unwrap()))
+}
+
+// GetString returns the value at 'path' in 'obj' formatted with validations.ToString.
+func GetString(obj any, path string) fnkit.Option[string] {
+	v := GetPath(obj, path)
+	if v.IsNone() {
+		return fnkit.None[string]()
+	}
+	return fnkit.Some(validations.ToString(v.Unwrap()))
+}
+
+func get(cur reflect.Value, segs []segment) (any, bool) {
+	for _, seg := range segs {
+		cur = indirect(cur)
+		if !cur.IsValid() {
+			return nil, false
+		}
+		switch cur.Kind() {
+		case reflect.Map:
+			key, ok := mapKey(cur.Type(), seg)
+			if !ok {
+				return nil, false
+			}
+			if cur = cur.MapIndex(key); !cur.IsValid() {
+				return nil, false
+			}
+		case reflect.Struct:
+			if seg.isIndex {
+				return nil, false
+			}
+			i, ok := structField(cur.Type(), seg.key)
+			if !ok {
+				return nil, false
+			}
+			cur = cur.Field(i)
+		case reflect.Slice, reflect.Array:
+			i, ok := seg.asIndex()
+			if !ok || i >= cur.Len() {
+				return nil, false
+			}
+			cur = cur.Index(i)
+		default:
+			return nil, false
+		}
+	}
+	if !cur.IsValid() {
+		return nil, false
+	}
+	return cur.Interface(), true
+}
+
+// SetPath stores 'value' at 'path' in 'obj', which must be a non-nil pointer or map.
+// Missing intermediate containers are created: a segment written as an index, "[2]",
+// creates a []any and any other segment a map[string]any, unless the surrounding type
+// says otherwise. A slice index may be at most the slice's length, which appends; larger
+// indexes are an error rather than padding, so untrusted paths cannot force huge
+// allocations. Numeric values are converted to the destination's numeric type when that
+// loses nothing: fractions, overflow and negative values for unsigned types are errors.
+// On error, obj is left unchanged.
+//
+//	nested.SetPath(doc, "users[0].tags[1]", "admin")
+func SetPath(obj any, path string, value any) error {
+	segs, err := parsePath(path)
+	if err != nil {
+		return err
+	}
+	rv := reflect.ValueOf(obj)
+	switch {
+	case rv.Kind() == reflect.Pointer && !rv.IsNil():
+		res, err := set(rv.Elem(), rv.Elem().Type(), segs, value)
+		if err != nil {
+			return err
+		}
+		rv.Elem().Set(res)
+		return nil
+	case rv.Kind() == reflect.Map && !rv.IsNil() && len(segs) > 0:
+		_, err := set(rv, rv.Type(), segs, value)
+		return err
+	}
+	return fmt.Errorf("nested: SetPath needs a non-nil pointer or map, got %T", obj)
+}
+
+// set returns cur, of type typ and possibly invalid if missing, with value stored at segs.
+// Maps and slice elements are updated in place; everything else is copied.
+func set(cur reflect.Value, typ reflect.Type, segs []segment, value any) (reflect.Value, error) {
+	if len(segs) == 0 {
+		return convert(value, typ)
+	}
+	seg := segs[0]
+	switch typ.Kind() {
+	case reflect.Interface:
+		var inner reflect.Value
+		var innerType reflect.Type
+		if cur.IsValid() && !cur.IsNil() {
+			inner = cur.Elem()
+			innerType = inner.Type()
+		} else if seg.isIndex {
+			innerType = reflect.TypeOf([]any(nil))
+		} else {
+			innerType = reflect.TypeOf(map[string]any(nil))
+		}
+		res, err := set(inner, innerType, segs, value)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		out := reflect.New(typ).Elem()
+		out.Set(res)
+		return out, nil
+	case reflect.Pointer:
+		p := cur
+		if !p.IsValid() || p.IsNil() {
+			p = reflect.New(typ.Elem())
+		}
+		res, err := set(p.Elem(), typ.Elem(), segs, value)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		p.Elem().Set(res)
+		return p, nil
+	case reflect.Map:
+		m := cur
+		if !m.IsValid() || m.IsNil() {
+			m = reflect.MakeMap(typ)
+		}
+		key, ok := mapKey(typ, seg)
+		if !ok {
+			return reflect.Value{}, fmt.Errorf("nested: cannot use %q as a key of %s", seg, typ)
+		}
+		res, err := set(m.MapIndex(key), typ.Elem(), segs[1:], value)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		m.SetMapIndex(key, res)
+		return m, nil
+	case reflect.Slice:
+		i, ok := seg.asIndex()
+		if !ok {
+			return reflect.Value{}, fmt.Errorf("nested: cannot use %q as an index of %s", seg, typ)
+		}
+		s := cur
+		if !s.IsValid() {
+			s = reflect.MakeSlice(typ, 0, 1)
+		}
+		if i > s.Len() {
+			return reflect.Value{}, fmt.Errorf("nested: index %d out of range for %s of length %d", i, typ, s.Len())
+		}
+		if i == s.Len() {
+			s = reflect.Append(s, reflect.Zero(typ.Elem()))
+		}
+		res, err := set(s.Index(i), typ.Elem(), segs[1:], value)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		s.Index(i).Set(res)
+		return s, nil
+	case reflect.Array:
+		i, ok := seg.asIndex()
+		if !ok || i >= typ.Len() {
+			return reflect.Value{}, fmt.Errorf("nested: cannot use %q as an index of %s", seg, typ)
+		}
+		a := reflect.New(typ).Elem()
+		if cur.IsValid() {
+			a.Set(cur)
+		}
+		res, err := set(a.Index(i), typ.Elem(), segs[1:], value)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		a.Index(i).Set(res)
+		return a, nil
+	case reflect.Struct:
+		fi, ok := structField(typ, seg.key)
+		if seg.isIndex || !ok {
+			return reflect.Value{}, fmt.Errorf("nested: %s has no exported field %q", typ, seg)
+		}
+		st := reflect.New(typ).Elem()
+		if cur.IsValid() {
+			st.Set(cur)
+		}
+		res, err := set(st.Field(fi), typ.Field(fi).Type, segs[1:], value)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		st.Field(fi).Set(res)
+		return st, nil
+	}
+	return reflect.Value{}, fmt.Errorf("nested: cannot set %q inside %s", seg, typ)
+}
+
+// convert returns value as a Value assignable to typ, converting between numeric types
+// when the value survives the conversion.
+func convert(value any, typ reflect.Type) (reflect.Value, error) {
+	if value == nil {
+		return reflect.Zero(typ), nil
+	}
+	v := reflect.ValueOf(value)
+	if v.Type().AssignableTo(typ) {
+		return v, nil
+	}
+	if isNumeric(v.Kind()) && isNumeric(typ.Kind()) {
+		if !fitsNumber(v, typ) {
+			return reflect.Value{}, fmt.Errorf("nested: %v does not fit in %s", value, typ)
+		}
+		return v.Convert(typ), nil
+	}
+	return reflect.Value{}, fmt.Errorf("nested: cannot use %T as %s", value, typ)
+}
+
+func isNumeric(k reflect.Kind) bool {
+	return k >= reflect.Int && k <= reflect.Float64
+}
+
+func isInt(k reflect.Kind) bool   { return k >= reflect.Int && k <= reflect.Int64 }
+func isFloat(k reflect.Kind) bool { return k == reflect.Float32 || k == reflect.Float64 }
+
+// fitsNumber reports whether the numeric value v converts to the numeric type typ without
+// losing a fraction, overflowing or changing sign. Narrowing between float types only has
+// to stay finite.
+func fitsNumber(v reflect.Value, typ reflect.Type) bool {
+	const two63, two64 = 1 << 63, 1 << 64
+	switch {
+	case isFloat(v.Kind()):
+		f := v.Float()
+		switch {
+		case isFloat(typ.Kind()):
+			return !math.IsInf(v.Convert(typ).Float(), 0) || math.IsInf(f, 0)
+		case f != math.Trunc(f) || math.IsInf(f, 0):
+			return false
+		case isInt(typ.Kind()):
+			limit := math.Ldexp(1, typ.Bits()-1)
+			return f >= -limit && f < limit
+		default:
+			return f >= 0 && f < math.Ldexp(1, typ.Bits())
+		}
+	case isInt(v.Kind()):
+		n := v.Int()
+		switch {
+		case isInt(typ.Kind()):
+			return !reflect.Zero(typ).OverflowInt(n)
+		case isFloat(typ.Kind()):
+			f := v.Convert(typ).Float()
+			return f >= -two63 && f < two63 && int64(f) == n
+		default:
+			return n >= 0 && !reflect.Zero(typ).OverflowUint(uint64(n))
+		}
+	default: // unsigned
+		u := v.Uint()
+		switch {
+		case isInt(typ.Kind()):
+			return u <= math.MaxInt64 && !reflect.Zero(typ).OverflowInt(int64(u))
+		case isFloat(typ.Kind()):
+			f := v.Convert(typ).Float()
+			return f < two64 && uint64(f) == u
+		default:
+			return !reflect.Zero(typ).OverflowUint(u)
+		}
+	}
+}
+
+// DeletePath removes the value at 'path' from 'obj', which must be a non-nil pointer or
+// map, and reports whether there was one. Map keys are deleted, slice elements are
+// removed (shifting later elements down) and array elements and struct fields are reset
+// to their zero value.
+func DeletePath(obj any, path string) bool {
+	segs, err := parsePath(path)
+	if err != nil || len(segs) == 0 {
+		return false
+	}
+	rv := reflect.ValueOf(obj)
+	switch {
+	case rv.Kind() == reflect.Pointer && !rv.IsNil():
+		res, ok := del(rv.Elem(), segs)
+		if ok {
+			rv.Elem().Set(res)
+		}
+		return ok
+	case rv.Kind() == reflect.Map && !rv.IsNil():
+		_, ok := del(rv, segs)
+		return ok
+	}
+	return false
+}
+
+// del returns cur with the value at segs removed, and whether anything was removed.
+func del(cur reflect.Value, segs []segment) (reflect.Value, bool) {
+	seg, last := segs[0], len(segs) == 1
+	switch cur.Kind() {
+	case reflect.Interface:
+		if cur.IsNil() {
+			return cur, false
+		}
+		res, ok := del(cur.Elem(), segs)
+		if !ok {
+			return cur, false
+		}
+		out := reflect.New(cur.Type()).Elem()
+		out.Set(res)
+		return out, true
+	case reflect.Pointer:
+		if cur.IsNil() {
+			return cur, false
+		}
+		res, ok := del(cur.Elem(), segs)
+		if ok {
+			cur.Elem().Set(res)
+		}
+		return cur, ok
+	case reflect.Map:
+		key, ok := mapKey(cur.Type(), seg)
+		if !ok {
+			return cur, false
+		}
+		child := cur.MapIndex(key)
+		if !child.IsValid() {
+			return cur, false
+		}
+		if last {
+			cur.SetMapIndex(key, reflect.Value{})
+			return cur, true
+		}
+		res, ok := del(child, segs[1:])
+		if ok {
+			cur.SetMapIndex(key, res)
+		}
+		return cur, ok
+	case reflect.Slice:
+		i, ok := seg.asIndex()
+		if !ok || i >= cur.Len() {
+			return cur, false
+		}
+		if last {
+			out := reflect.MakeSlice(cur.Type(), 0, cur.Len()-1)
+			out = reflect.AppendSlice(out, cur.Slice(0, i))
+			return reflect.AppendSlice(out, cur.Slice(i+1, cur.Len())), true
+		}
+		res, ok := del(cur.Index(i), segs[1:])
+		if ok {
+			cur.Index(i).Set(res)
+		}
+		return cur, ok
+	case reflect.Array, reflect.Struct:
+		var field reflect.Value
+		out := reflect.New(cur.Type()).Elem()
+		out.Set(cur)
+		if cur.Kind() == reflect.Array {
+			i, ok := seg.asIndex()
+			if !ok || i >= cur.Len() {
+				return cur, false
+			}
+			field = out.Index(i)
+		} else {
+			i, ok := structField(cur.Type(), seg.key)
+			if seg.isIndex || !ok {
+				return cur, false
+			}
+			field = out.Field(i)
+		}
+		if last {
+			field.SetZero()
+			return out, true
+		}
+		res, ok := del(field, segs[1:])
+		if !ok {
+			return cur, false
+		}
+		field.Set(res)
+		return out, true
+	}
+	return cur, false
+}
+
+// Flatten turns nested maps, slices and structs into a single-level map whose keys are
+// paths, as accepted by GetPath:
+//
+//	nested.Flatten(map[string]any{"a": map[string]any{"b": []any{1, 2}}})
+//	// map[string]any{"a.b[0]": 1, "a.b[1]": 2}
+//
+// Empty maps and slices, and structs without exported fields (such as time.Time), are
+// kept as values. Struct fields are named by their json tag when they have one.
+func Flatten(obj any) map[string]any {
+	out := make(map[string]any)
+	flatten(reflect.ValueOf(obj), nil, out)
+	return out
+}
+
+func flatten(v reflect.Value, prefix []segment, out map[string]any) {
+	leaf := v
+	v = indirect(v)
+	children := 0
+	add := func(seg segment, child reflect.Value) {
+		children++
+		flatten(child, append(prefix[:len(prefix):len(prefix)], seg), out)
+	}
+	switch v.Kind() {
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			add(segment{key: fmt.Sprint(iter.Key().Interface())}, iter.Value())
+		}
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			break // []byte is a value, not a list
+		}
+		for i := 0; i < v.Len(); i++ {
+			add(segment{index: i, isIndex: true}, v.Index(i))
+		}
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			if name, ok := fieldName(t.Field(i)); ok {
+				add(segment{key: name}, v.Field(i))
+			}
+		}
+	}
+	if children > 0 {
+		return
+	}
+	if !leaf.IsValid() {
+		out[formatPath(prefix)] = nil
+		return
+	}
+	out[formatPath(prefix)] = leaf.Interface()
+}
+
+// Unflatten is the inverse of Flatten: it builds nested map[string]any and []any values
+// from a map of paths. Keys are applied in path order, with indexes compared numerically
+// so that "a[2]" comes before "a[10]". It fails on malformed paths, on conflicting keys
+// such as "a" and "a.b", and on gaps in slice indexes.
+func Unflatten(flat map[string]any) (map[string]any, error) {
+	type entry struct {
+		key  string
+		segs []segment
+	}
+	entries := make([]entry, 0, len(flat))
+	for k := range flat {
+		segs, err := parsePath(k)
+		if err != nil {
+			return nil, fmt.Errorf("nested: Unflatten %q: %w", k, err)
+		}
+		entries = append(entries, entry{k, segs})
+	}
+	slices.SortFunc(entries, func(a, b entry) int {
+		return slices.CompareFunc(a.segs, b.segs, compareSegments)
+	})
+	out := make(map[string]any)
+	for _, e := range entries {
+		if err := SetPath(out, e.key, flat[e.key]); err != nil {
+			return nil, fmt.Errorf("nested: Unflatten %q: %w", e.key, err)
+		}
+	}
+	return out, nil
+}
+
+// compareSegments orders indexes numerically and before keys, and keys as strings.
+func compareSegments(a, b segment) int {
+	switch {
+	case a.isIndex && b.isIndex:
+		return cmp.Compare(a.index, b.index)
+	case a.isIndex != b.isIndex:
+		if a.isIndex {
+			return -1
+		}
+		return 1
+	}
+	return strings.Compare(a.key, b.key)
+}
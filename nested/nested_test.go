@@ -0,0 +1,305 @@
+package nested_test
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/kishankumarhs/fnkit/nested"
+)
+
+func decode(t *testing.T, s string) map[string]any {
+	t.Helper()
+	var m map[string]any
+	if err := json.Unmarshal([]byte(s), &m); err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+const doc = `{
+	"users": [
+		{"name": "ada", "age": 36, "tags": ["admin"], "address": {"city": "London"}},
+		{"name": "bob", "age": "25", "nick": null}
+	],
+	"labels": {"app.kubernetes.io/name": "web"}
+}`
+
+func TestGetPath(t *testing.T) {
+	m := decode(t, doc)
+	cases := []struct {
+		path string
+		want any
+		ok   bool
+	}{
+		{"users[0].name", "ada", true},
+		{"users.0.name", "ada", true},
+		{"users[0].address.city", "London", true},
+		{"users[0].tags[0]", "admin", true},
+		{`labels["app.kubernetes.io/name"]`, "web", true},
+		{"users[1].nick", nil, true},
+		{"users[2].name", nil, false},
+		{"users[0].missing", nil, false},
+		{"users[0].name.first", nil, false},
+		{"users[x]", nil, false},
+		{"users..name", nil, false},
+	}
+	for _, c := range cases {
+		got := nested.GetPath(m, c.path)
+		if got.IsSome() != c.ok || (c.ok && !reflect.DeepEqual(got.Unwrap(), c.want)) {
+			t.Errorf("GetPath(%q) = %+v, want %v (ok=%v)", c.path, got, c.want, c.ok)
+		}
+	}
+	if !nested.HasPath(m, "users[1].nick") || nested.HasPath(m, "users[1].email") {
+		t.Error("HasPath gave the wrong answer")
+	}
+	if got := nested.GetPath(m, ""); !reflect.DeepEqual(got.Unwrap(), m) {
+		t.Error(`GetPath("") should return the object itself`)
+	}
+}
+
+func TestTypedGetters(t *testing.T) {
+	m := decode(t, doc)
+	if got := nested.GetInt(m, "users[0].age"); got.Unwrap() != 36 {
+		t.Errorf("GetInt(float64) = %+v", got)
+	}
+	if got := nested.GetInt(m, "users[1].age"); got.Unwrap() != 25 {
+		t.Errorf("GetInt(numeric string) = %+v", got)
+	}
+	if nested.GetInt(m, "users[0].name").IsSome() || nested.GetInt(m, "nope").IsSome() {
+		t.Error("GetInt should be None for non-numbers and missing paths")
+	}
+	if got := nested.GetFloat64(m, "users[1].age"); got.Unwrap() != 25 {
+		t.Errorf("GetFloat64 = %+v", got)
+	}
+	if got := nested.GetString(m, "users[0].age"); got.Unwrap() != "36" {
+		t.Errorf("GetString = %+v", got)
+	}
+	if got := nested.GetAs[[]any](m, "users[0].tags"); len(got.Unwrap()) != 1 {
+		t.Errorf("GetAs = %+v", got)
+	}
+	if nested.GetAs[string](m, "users[0].age").IsSome() {
+		t.Error("GetAs[string] on a number should be None")
+	}
+}
+
+type address struct {
+	City string `json:"city"`
+}
+
+type user struct {
+	Name    string
+	Address *address `json:"address"`
+	Scores  []int
+	Tags    map[string]string
+	secret  string
+}
+
+func TestStructs(t *testing.T) {
+	u := user{Name: "ada", Address: &address{City: "London"}, secret: "x"}
+	if got := nested.GetPath(u, "address.city"); got.Unwrap() != "London" {
+		t.Errorf("GetPath via json tag = %+v", got)
+	}
+	if got := nested.GetPath(&u, "Address.City"); got.Unwrap() != "London" {
+		t.Errorf("GetPath via field name = %+v", got)
+	}
+	if nested.HasPath(u, "secret") {
+		t.Error("unexported fields must not be reachable")
+	}
+
+	if err := nested.SetPath(&u, "Scores[0]", 1.0); err != nil {
+		t.Fatal(err)
+	}
+	if err := nested.SetPath(&u, "Scores[1]", int8(7)); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(u.Scores, []int{1, 7}) {
+		t.Errorf("SetPath appended to Scores: %v", u.Scores)
+	}
+	if err := nested.SetPath(&u, "Scores[3]", 1); err == nil || len(u.Scores) != 2 {
+		t.Errorf("SetPath past the end of a slice = %v, %v", u.Scores, err)
+	}
+	if err := nested.SetPath(&u, "Tags.env", "prod"); err != nil || u.Tags["env"] != "prod" {
+		t.Errorf("SetPath into nil map = %v, %v", u.Tags, err)
+	}
+	var empty user
+	if err := nested.SetPath(&empty, "address.city", "Paris"); err != nil || empty.Address == nil || empty.Address.City != "Paris" {
+		t.Errorf("SetPath through nil pointer = %+v, %v", empty.Address, err)
+	}
+	if err := nested.SetPath(&u, "Name", 5); err == nil {
+		t.Error("SetPath with mismatched type should fail")
+	}
+	if err := nested.SetPath(&u, "secret", "y"); err == nil || u.secret != "x" {
+		t.Errorf("SetPath on unexported field = %v", err)
+	}
+	if err := nested.SetPath(u, "Name", "x"); err == nil {
+		t.Error("SetPath on a non-pointer struct should fail")
+	}
+
+	if !nested.DeletePath(&u, "Scores[0]") || !reflect.DeepEqual(u.Scores, []int{7}) {
+		t.Errorf("DeletePath slice element = %v", u.Scores)
+	}
+	if !nested.DeletePath(&u, "address") || u.Address != nil {
+		t.Errorf("DeletePath struct field = %+v", u.Address)
+	}
+}
+
+func TestSetPathCreatesContainers(t *testing.T) {
+	m := map[string]any{}
+	steps := []struct {
+		path  string
+		value any
+	}{
+		{"users[0].tags[0]", "admin"},
+		{"users[0].name", "ada"},
+		{"users[1].name", "bob"},
+		{`labels["a.b"]`, "c"},
+		{"count", 3},
+	}
+	for _, s := range steps {
+		if err := nested.SetPath(m, s.path, s.value); err != nil {
+			t.Fatalf("SetPath(%q) = %v", s.path, err)
+		}
+	}
+	want := decode(t, `{
+		"users": [{"name": "ada", "tags": ["admin"]}, {"name": "bob"}],
+		"labels": {"a.b": "c"},
+		"count": 3
+	}`)
+	want["count"] = 3
+	if !reflect.DeepEqual(m, want) {
+		t.Errorf("SetPath result = %#v\nwant %#v", m, want)
+	}
+
+	if err := nested.SetPath(m, "users[1000000000000]", 1); err == nil {
+		t.Error("SetPath with a huge index should fail instead of allocating")
+	}
+	if err := nested.SetPath(m, "count.x", 1); err == nil {
+		t.Error("SetPath through a number should fail")
+	}
+	if err := nested.SetPath(m, "a[", 1); !errors.Is(err, nested.ErrInvalidPath) {
+		t.Errorf("SetPath with bad path = %v, want ErrInvalidPath", err)
+	}
+	if err := nested.SetPath(map[string]any(nil), "a", 1); err == nil {
+		t.Error("SetPath on a nil map should fail")
+	}
+
+	var v any
+	if err := nested.SetPath(&v, "a[0]", true); err != nil || !reflect.DeepEqual(v, map[string]any{"a": []any{true}}) {
+		t.Errorf("SetPath on *any = %#v, %v", v, err)
+	}
+}
+
+func TestSetPathNumericConversion(t *testing.T) {
+	ok := []struct {
+		dst  any
+		val  any
+		want any
+	}{
+		{map[string]int{}, 5.0, 5},
+		{map[string]uint8{}, 255, uint8(255)},
+		{map[string]int64{}, uint32(7), int64(7)},
+		{map[string]float32{}, 0.1, float32(0.1)},
+		{map[string]float64{}, 1 << 53, float64(1 << 53)},
+	}
+	for _, c := range ok {
+		if err := nested.SetPath(c.dst, "a", c.val); err != nil {
+			t.Errorf("SetPath(%T, %v) = %v", c.dst, c.val, err)
+			continue
+		}
+		if got := reflect.ValueOf(c.dst).MapIndex(reflect.ValueOf("a")).Interface(); got != c.want {
+			t.Errorf("SetPath(%T, %v) stored %v", c.dst, c.val, got)
+		}
+	}
+	lossy := []struct {
+		dst any
+		val any
+	}{
+		{map[string]int{}, 5.7},
+		{map[string]int8{}, 128},
+		{map[string]uint{}, -1},
+		{map[string]uint{}, -1.0},
+		{map[string]int64{}, uint64(math.MaxUint64)},
+		{map[string]int32{}, math.Inf(1)},
+		{map[string]int{}, math.NaN()},
+		{map[string]float64{}, int64(1<<53 + 1)},
+		{map[string]float32{}, 1e300},
+	}
+	for _, c := range lossy {
+		if err := nested.SetPath(c.dst, "a", c.val); err == nil {
+			t.Errorf("SetPath(%T, %v) should reject a lossy conversion", c.dst, c.val)
+		}
+		if reflect.ValueOf(c.dst).Len() != 0 {
+			t.Errorf("SetPath(%T, %v) modified the map on error", c.dst, c.val)
+		}
+	}
+}
+
+func TestDeletePath(t *testing.T) {
+	m := decode(t, doc)
+	if !nested.DeletePath(m, "users[0].address.city") || nested.HasPath(m, "users[0].address.city") {
+		t.Error("DeletePath of a map key failed")
+	}
+	if !nested.DeletePath(m, "users[0]") || nested.GetString(m, "users[0].name").Unwrap() != "bob" {
+		t.Error("DeletePath of a slice element failed")
+	}
+	if nested.DeletePath(m, "users[5]") || nested.DeletePath(m, "nope.deeper") || nested.DeletePath(m, "") {
+		t.Error("DeletePath of a missing path should report false")
+	}
+	if !nested.DeletePath(m, "users[0].nick") || nested.HasPath(m, "users[0].nick") {
+		t.Error("DeletePath of a null value failed")
+	}
+}
+
+func TestFlattenUnflatten(t *testing.T) {
+	m := decode(t, `{"a": {"b": [1, {"c": 2}], "empty": {}}, "x.y": "dotted", "n": null}`)
+	flat := nested.Flatten(m)
+	want := map[string]any{
+		"a.b[0]":   1.0,
+		"a.b[1].c": 2.0,
+		"a.empty":  map[string]any{},
+		`["x.y"]`:  "dotted",
+		"n":        nil,
+	}
+	if !reflect.DeepEqual(flat, want) {
+		t.Errorf("Flatten = %#v\nwant %#v", flat, want)
+	}
+	back, err := nested.Unflatten(flat)
+	if err != nil || !reflect.DeepEqual(back, m) {
+		t.Errorf("Unflatten(Flatten(m)) = %#v, %v\nwant %#v", back, err, m)
+	}
+
+	when := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	type event struct {
+		Name string    `json:"name"`
+		At   time.Time `json:"at"`
+		Skip string    `json:"-"`
+	}
+	if got := nested.Flatten(event{Name: "launch", At: when, Skip: "x"}); !reflect.DeepEqual(got, map[string]any{"name": "launch", "at": when}) {
+		t.Errorf("Flatten(struct) = %#v", got)
+	}
+
+	long := map[string]any{"xs": []any{0.0, 1.0, 2.0, 3.0, 4.0, 5.0, 6.0, 7.0, 8.0, 9.0, 10.0, 11.0}}
+	if back, err := nested.Unflatten(nested.Flatten(long)); err != nil || !reflect.DeepEqual(back, long) {
+		t.Errorf("Unflatten of 12 elements = %v, %v", back, err)
+	}
+	odd := map[string]any{`q"]`: 1.0, `back\slash`: map[string]any{`a]b.c`: 2.0}, `it's`: 3.0, "": 4.0}
+	if back, err := nested.Unflatten(nested.Flatten(odd)); err != nil || !reflect.DeepEqual(back, odd) {
+		t.Errorf("Unflatten(Flatten(odd keys)) = %#v, %v", back, err)
+	}
+	if got := nested.GetInt(map[string]any{`say "hi"`: 1}, `['say "hi"']`); got.UnwrapOr(0) != 1 {
+		t.Errorf("GetInt with a single-quoted key = %v", got)
+	}
+	if err := nested.SetPath(odd, `["q\"]`, 1); !errors.Is(err, nested.ErrInvalidPath) {
+		t.Errorf("unterminated quoted key = %v, want ErrInvalidPath", err)
+	}
+	if _, err := nested.Unflatten(map[string]any{"a[1]": 1}); err == nil {
+		t.Error("Unflatten with a gap in slice indexes should fail")
+	}
+	if _, err := nested.Unflatten(map[string]any{"a": 1, "a.b": 2}); err == nil {
+		t.Error("Unflatten with conflicting keys should fail")
+	}
+}
@@ -0,0 +1,210 @@
+// Package nested reads and writes values deep inside dynamic data, such as decoded JSON,
+// using paths like "users[0].address.city".
+//
+// A path is a sequence of segments separated by dots. A segment is a map key, an exported
+// struct field (by name or json tag) or a slice or array index. Indexes can be written in
+// brackets, "items[2]", or as a plain segment, "items.2". Keys that contain dots or
+// brackets can be quoted in brackets: `labels["app.kubernetes.io/name"]`; inside the
+// quotes a backslash escapes the next character, as in `["say \"hi\""]`. Pointers and
+// interfaces are followed transparently. The empty path refers to the value itself.
+package nested
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidPath is returned for paths that cannot be parsed, such as "a[x" or "a..b".
+var ErrInvalidPath = errors.New("nested: invalid path")
+
+// segment is one step of a path: a key, or an index written in brackets.
+type segment struct {
+	key     string
+	index   int
+	isIndex bool
+}
+
+func (s segment) String() string {
+	if s.isIndex {
+		return "[" + strconv.Itoa(s.index) + "]"
+	}
+	return s.key
+}
+
+// asIndex returns the segment as a slice index; plain keys made of digits qualify.
+func (s segment) asIndex() (int, bool) {
+	if s.isIndex {
+		return s.index, true
+	}
+	i, err := strconv.Atoi(s.key)
+	return i, err == nil && i >= 0 && strings.Trim(s.key, "0123456789") == ""
+}
+
+// parsePath splits a path into segments.
+func parsePath(path string) ([]segment, error) {
+	var segs []segment
+	invalid := func(why string) error {
+		return fmt.Errorf("%w %q: %s", ErrInvalidPath, path, why)
+	}
+	i := 0
+	expectKey := true // at the start or after a dot, a key (or bracket) must follow
+	for i < len(path) {
+		switch c := path[i]; {
+		case c == '[' && i+1 < len(path) && (path[i+1] == '"' || path[i+1] == '\''):
+			key, n, ok := unquote(path[i+1:])
+			if !ok || i+1+n >= len(path) || path[i+1+n] != ']' {
+				return nil, invalid("unclosed quoted key")
+			}
+			segs = append(segs, segment{key: key})
+			i += n + 2
+			expectKey = false
+		case c == '[':
+			end := strings.IndexByte(path[i:], ']')
+			if end < 0 {
+				return nil, invalid("unclosed [")
+			}
+			inner := path[i+1 : i+end]
+			if n, err := strconv.Atoi(inner); err == nil && n >= 0 && inner[0] != '+' {
+				segs = append(segs, segment{index: n, isIndex: true})
+			} else {
+				return nil, invalid(fmt.Sprintf("bad index [%s]", inner))
+			}
+			i += end + 1
+			expectKey = false
+		case c == '.':
+			if expectKey {
+				return nil, invalid("empty segment")
+			}
+			i++
+			expectKey = true
+			if i == len(path) {
+				return nil, invalid("trailing dot")
+			}
+		default:
+			if !expectKey {
+				return nil, invalid("missing dot after ]")
+			}
+			end := strings.IndexAny(path[i:], ".[")
+			if end < 0 {
+				end = len(path) - i
+			}
+			segs = append(segs, segment{key: path[i : i+end]})
+			i += end
+			expectKey = false
+		}
+	}
+	return segs, nil
+}
+
+// unquote reads the quoted key at the start of s, where a backslash escapes the next
+// character, and returns it with the number of bytes it took up, quotes included.
+func unquote(s string) (key string, n int, ok bool) {
+	var b strings.Builder
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case s[0]:
+			return b.String(), i + 1, true
+		case '\\':
+			i++
+			if i == len(s) {
+				return "", 0, false
+			}
+		}
+		b.WriteByte(s[i])
+	}
+	return "", 0, false
+}
+
+var keyEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+// formatPath is the inverse of parsePath, quoting keys that would not parse back as written.
+func formatPath(segs []segment) string {
+	var b strings.Builder
+	for i, s := range segs {
+		switch {
+		case s.isIndex:
+			b.WriteString(s.String())
+		case s.key == "" || strings.ContainsAny(s.key, ".[]\"'"):
+			b.WriteString(`["` + keyEscaper.Replace(s.key) + `"]`)
+		default:
+			if i > 0 {
+				b.WriteByte('.')
+			}
+			b.WriteString(s.key)
+		}
+	}
+	return b.String()
+}
+
+// indirect follows pointers and interfaces, returning the zero Value for nil.
+func indirect(v reflect.Value) reflect.Value {
+	for v.IsValid() && (v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+// structField returns the index of the exported field of t named name, or whose json tag
+// is name.
+func structField(t reflect.Type, name string) (int, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		if tag, _, _ := strings.Cut(f.Tag.Get("json"), ","); tag == name {
+			return i, true
+		}
+	}
+	if f, ok := t.FieldByName(name); ok && f.IsExported() && len(f.Index) == 1 {
+		return f.Index[0], true
+	}
+	return 0, false
+}
+
+// fieldName returns the name Flatten uses for a struct field: its json tag name if it has
+// one, otherwise its Go name. ok is false for unexported fields and fields tagged "-".
+func fieldName(f reflect.StructField) (name string, ok bool) {
+	if !f.IsExported() {
+		return "", false
+	}
+	tag, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+	switch tag {
+	case "-":
+		return "", false
+	case "":
+		return f.Name, true
+	}
+	return tag, true
+}
+
+// mapKey converts a path segment to a key of the map type t, which must have string,
+// integer or interface keys.
+func mapKey(t reflect.Type, s segment) (reflect.Value, bool) {
+	text := s.key
+	if s.isIndex {
+		text = strconv.Itoa(s.index)
+	}
+	kt := t.Key()
+	switch kt.Kind() {
+	case reflect.String:
+		return reflect.ValueOf(text).Convert(kt), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(text, 10, 64)
+		if err != nil || reflect.Zero(kt).OverflowInt(n) {
+			return reflect.Value{}, false
+		}
+		return reflect.ValueOf(n).Convert(kt), true
+	case reflect.Interface:
+		if reflect.TypeOf(text).Implements(kt) {
+			return reflect.ValueOf(text), true
+		}
+	}
+	return reflect.Value{}, false
+}
@@ -43,3 +43,61 @@ func (o Option[T]) UnwrapOr(fallback T) T {
 	}
 	return fallback
 }
+
+// OptionOf wraps a (value, ok) pair, as returned by Find, Pop or a map lookup, in an Option.
+func OptionOf[T any](val T, ok bool) Option[T] {
+	if !ok {
+		return None[T]()
+	}
+	return Some(val)
+}
+
+// AtOpt is At returning None instead of the zero value when 'index' is out of range.
+// Negative indexes count from the end, as in At.
+func AtOpt[K any](s []K, index int) Option[K] {
+	if index < 0 {
+		index += len(s)
+	}
+	return Nth(s, index)
+}
+
+// Nth returns the element at position 'n' of the slice 's', or None if n is negative or
+// past the end. Unlike AtOpt, negative positions do not count from the end.
+func Nth[K any](s []K, n int) Option[K] {
+	if n < 0 || n >= len(s) {
+		return None[K]()
+	}
+	return Some(s[n])
+}
+
+// FirstOpt returns the first element of the slice 's', or None if it is empty.
+func FirstOpt[K any](s []K) Option[K] {
+	return Nth(s, 0)
+}
+
+// LastOpt returns the last element of the slice 's', or None if it is empty.
+func LastOpt[K any](s []K) Option[K] {
+	return Nth(s, len(s)-1)
+}
+
+// FindOpt is Find returning an Option.
+func FindOpt[K any](s []K, f func(K) bool) Option[K] {
+	return OptionOf(Find(s, f))
+}
+
+// PopOpt is Pop returning an Option.
+func PopOpt[K any](s *[]K) Option[K] {
+	return OptionOf(Pop(s))
+}
+
+// ShiftOpt is Shift returning an Option.
+func ShiftOpt[K any](s *[]K) Option[K] {
+	return OptionOf(Shift(s))
+}
+
+// Get returns the value stored in the map 'm' under 'key', or None if there is none.
+// A key that is present with a zero value gives Some(zero).
+func Get[M ~map[K]V, K comparable, V any](m M, key K) Option[V] {
+	v, ok := m[key]
+	return OptionOf(v, ok)
+}
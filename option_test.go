@@ -0,0 +1,60 @@
+package fnkit
+
+import "testing"
+
+func TestSliceOptionAccessors(t *testing.T) {
+	s := []int{0, 10, 20}
+	cases := []struct {
+		name string
+		got  Option[int]
+		want Option[int]
+	}{
+		{"AtOpt(0)", AtOpt(s, 0), Some(0)},
+		{"AtOpt(-1)", AtOpt(s, -1), Some(20)},
+		{"AtOpt(3)", AtOpt(s, 3), None[int]()},
+		{"AtOpt(-4)", AtOpt(s, -4), None[int]()},
+		{"Nth(1)", Nth(s, 1), Some(10)},
+		{"Nth(-1)", Nth(s, -1), None[int]()},
+		{"FirstOpt", FirstOpt(s), Some(0)},
+		{"LastOpt", LastOpt(s), Some(20)},
+		{"FirstOpt(empty)", FirstOpt([]int{}), None[int]()},
+		{"LastOpt(nil)", LastOpt[int](nil), None[int]()},
+		{"FindOpt", FindOpt(s, func(v int) bool { return v > 5 }), Some(10)},
+		{"FindOpt(missing)", FindOpt(s, func(v int) bool { return v > 50 }), None[int]()},
+	}
+	for _, c := range cases {
+		if c.got != c.want {
+			t.Errorf("%s = %+v, want %+v", c.name, c.got, c.want)
+		}
+	}
+}
+
+func TestPopShiftOpt(t *testing.T) {
+	s := []string{"a", "b"}
+	if got := PopOpt(&s); got.Unwrap() != "b" || len(s) != 1 {
+		t.Errorf("PopOpt = %+v, s = %v", got, s)
+	}
+	if got := ShiftOpt(&s); got.Unwrap() != "a" || len(s) != 0 {
+		t.Errorf("ShiftOpt = %+v, s = %v", got, s)
+	}
+	if PopOpt(&s).IsSome() || ShiftOpt(&s).IsSome() {
+		t.Error("PopOpt/ShiftOpt on empty slice should be None")
+	}
+}
+
+func TestGet(t *testing.T) {
+	type counts map[string]int
+	m := counts{"zero": 0, "one": 1}
+	if got := Get(m, "zero"); got != Some(0) {
+		t.Errorf("Get(zero) = %+v, want Some(0)", got)
+	}
+	if got := Get(m, "missing"); got.IsSome() {
+		t.Errorf("Get(missing) = %+v, want None", got)
+	}
+	if got := Get(map[int]string(nil), 1); got.IsSome() {
+		t.Errorf("Get on nil map = %+v", got)
+	}
+	if OptionOf(5, false).IsSome() || OptionOf(5, true).Unwrap() != 5 {
+		t.Error("OptionOf gave the wrong answer")
+	}
+}
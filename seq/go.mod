@@ -0,0 +1,3 @@
+module github.com/kishankumarhs/fnkit/seq
+
+go 1.23.2
@@ -0,0 +1,417 @@
+// Package seq provides the fnkit slice helpers over iter.Seq and iter.Seq2.
+//
+// Functions that return a sequence are lazy: nothing runs until the result is ranged
+// over, and stopping the range early stops the source too. Functions that return a value
+// (Reduce, Find, Every, GroupBy, ...) consume the sequence, stopping as soon as the
+// answer is known. Use FromSlice and Collect to move between slices and sequences.
+package seq
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+)
+
+// FromSlice returns a sequence of the elements of the slice 's'.
+func FromSlice[K any](s []K) iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for _, v := range s {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Of returns a sequence of its arguments.
+func Of[K any](values ...K) iter.Seq[K] {
+	return FromSlice(values)
+}
+
+// Collect gathers the elements of 'seq' into a new slice.
+func Collect[K any](seq iter.Seq[K]) []K {
+	var result []K
+	for v := range seq {
+		result = append(result, v)
+	}
+	return result
+}
+
+// Map returns a sequence of 'f' applied to each element of 'seq'.
+func Map[K any, T any](seq iter.Seq[K], f func(K) T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range seq {
+			if !yield(f(v)) {
+				return
+			}
+		}
+	}
+}
+
+// Filter returns a sequence of the elements of 'seq' that satisfy the predicate 'f'.
+func Filter[K any](seq iter.Seq[K], f func(K) bool) iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for v := range seq {
+			if f(v) && !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// FlatMap returns the concatenation of the sequences returned by 'f' for each element of 'seq'.
+func FlatMap[K any, T any](seq iter.Seq[K], f func(K) iter.Seq[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range seq {
+			for t := range f(v) {
+				if !yield(t) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Flat flattens a sequence of sequences.
+func Flat[K any](seq iter.Seq[iter.Seq[K]]) iter.Seq[K] {
+	return FlatMap(seq, func(s iter.Seq[K]) iter.Seq[K] { return s })
+}
+
+// Concat returns the elements of each sequence in turn.
+func Concat[K any](seqs ...iter.Seq[K]) iter.Seq[K] {
+	return Flat(FromSlice(seqs))
+}
+
+// Take returns the first 'n' elements of 'seq', stopping the source after them.
+func Take[K any](seq iter.Seq[K], n int) iter.Seq[K] {
+	return func(yield func(K) bool) {
+		if n <= 0 {
+			return
+		}
+		i := 0
+		for v := range seq {
+			if !yield(v) {
+				return
+			}
+			if i++; i == n {
+				return
+			}
+		}
+	}
+}
+
+// Drop returns the elements of 'seq' after the first 'n'.
+func Drop[K any](seq iter.Seq[K], n int) iter.Seq[K] {
+	return func(yield func(K) bool) {
+		i := 0
+		for v := range seq {
+			if i < n {
+				i++
+				continue
+			}
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// TakeWhile returns the elements of 'seq' up to, not including, the first one that does
+// not satisfy 'f'.
+func TakeWhile[K any](seq iter.Seq[K], f func(K) bool) iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for v := range seq {
+			if !f(v) || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// DropWhile returns the elements of 'seq' from the first one that does not satisfy 'f' on.
+func DropWhile[K any](seq iter.Seq[K], f func(K) bool) iter.Seq[K] {
+	return func(yield func(K) bool) {
+		dropping := true
+		for v := range seq {
+			if dropping && f(v) {
+				continue
+			}
+			dropping = false
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Unique returns the elements of 'seq' with duplicates removed, keeping the first of each.
+// It remembers every distinct element seen.
+func Unique[K comparable](seq iter.Seq[K]) iter.Seq[K] {
+	return UniqueBy(seq, func(v K) K { return v })
+}
+
+// UniqueBy returns the elements of 'seq' with duplicate keys removed, keeping the first
+// element for each key.
+func UniqueBy[K any, T comparable](seq iter.Seq[K], keyFn func(K) T) iter.Seq[K] {
+	return func(yield func(K) bool) {
+		seen := make(map[T]struct{})
+		for v := range seq {
+			k := keyFn(v)
+			if _, ok := seen[k]; ok {
+				continue
+			}
+			seen[k] = struct{}{}
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Chunk groups the elements of 'seq' into slices of 'size' elements; the last chunk may
+// be shorter. Each chunk is a new slice that the caller may keep. It yields nothing if
+// size is not positive.
+func Chunk[K any](seq iter.Seq[K], size int) iter.Seq[[]K] {
+	return func(yield func([]K) bool) {
+		if size <= 0 {
+			return
+		}
+		chunk := make([]K, 0, size)
+		for v := range seq {
+			chunk = append(chunk, v)
+			if len(chunk) == size {
+				if !yield(chunk) {
+					return
+				}
+				chunk = make([]K, 0, size)
+			}
+		}
+		if len(chunk) > 0 {
+			yield(chunk)
+		}
+	}
+}
+
+// Window returns sliding windows of 'size' consecutive elements of 'seq', starting every
+// 'step' elements, as fnkit.Window does for slices. Only full windows are yielded, and
+// each is a new slice. It yields nothing if size or step is not positive.
+func Window[K any](seq iter.Seq[K], size, step int) iter.Seq[[]K] {
+	return func(yield func([]K) bool) {
+		if size <= 0 || step <= 0 {
+			return
+		}
+		var buf []K
+		skip := 0 // elements still to discard before the next window starts
+		for v := range seq {
+			if skip > 0 {
+				skip--
+				continue
+			}
+			buf = append(buf, v)
+			if len(buf) < size {
+				continue
+			}
+			window := make([]K, size)
+			copy(window, buf)
+			if !yield(window) {
+				return
+			}
+			if step < size {
+				buf = append(buf[:0], buf[step:]...)
+			} else {
+				buf, skip = buf[:0], step-size
+			}
+		}
+	}
+}
+
+// Scan returns the running accumulator of reducing 'seq' with 'f', starting from 'initial'.
+// The initial value itself is not yielded.
+func Scan[K any, T any](seq iter.Seq[K], initial T, f func(T, K) T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		accumulator := initial
+		for v := range seq {
+			accumulator = f(accumulator, v)
+			if !yield(accumulator) {
+				return
+			}
+		}
+	}
+}
+
+// Enumerate pairs each element of 'seq' with its position, starting at 0.
+func Enumerate[K any](seq iter.Seq[K]) iter.Seq2[int, K] {
+	return func(yield func(int, K) bool) {
+		i := 0
+		for v := range seq {
+			if !yield(i, v) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// Zip pairs up the elements of 'a' and 'b' by position, stopping when either ends.
+func Zip[A, B any](a iter.Seq[A], b iter.Seq[B]) iter.Seq2[A, B] {
+	return func(yield func(A, B) bool) {
+		nextB, stop := iter.Pull(b)
+		defer stop()
+		for va := range a {
+			vb, ok := nextB()
+			if !ok || !yield(va, vb) {
+				return
+			}
+		}
+	}
+}
+
+// Reduce applies 'f' to an accumulator, starting from 'initial', and each element of 'seq'.
+func Reduce[K any, T any](seq iter.Seq[K], initial T, f func(T, K) T) T {
+	accumulator := initial
+	for v := range seq {
+		accumulator = f(accumulator, v)
+	}
+	return accumulator
+}
+
+// ForEach calls 'f' for each element of 'seq'.
+func ForEach[K any](seq iter.Seq[K], f func(K)) {
+	for v := range seq {
+		f(v)
+	}
+}
+
+// Count returns the number of elements in 'seq'.
+func Count[K any](seq iter.Seq[K]) int {
+	n := 0
+	for range seq {
+		n++
+	}
+	return n
+}
+
+// Find returns the first element of 'seq' that satisfies 'f', and whether there was one.
+func Find[K any](seq iter.Seq[K], f func(K) bool) (K, bool) {
+	for v := range seq {
+		if f(v) {
+			return v, true
+		}
+	}
+	var zero K
+	return zero, false
+}
+
+// FindIndex returns the position of the first element of 'seq' that satisfies 'f', or -1.
+func FindIndex[K any](seq iter.Seq[K], f func(K) bool) int {
+	for i, v := range Enumerate(seq) {
+		if f(v) {
+			return i
+		}
+	}
+	return -1
+}
+
+// IndexOf returns the position of the first element of 'seq' equal to 'value', or -1.
+func IndexOf[K comparable](seq iter.Seq[K], value K) int {
+	return FindIndex(seq, func(v K) bool { return v == value })
+}
+
+// Includes reports whether 'seq' contains 'value'.
+func Includes[K comparable](seq iter.Seq[K], value K) bool {
+	return IndexOf(seq, value) >= 0
+}
+
+// Every reports whether every element of 'seq' satisfies 'f'. It is true for an empty sequence.
+func Every[K any](seq iter.Seq[K], f func(K) bool) bool {
+	for v := range seq {
+		if !f(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// Any reports whether some element of 'seq' satisfies 'f'.
+func Any[K any](seq iter.Seq[K], f func(K) bool) bool {
+	_, ok := Find(seq, f)
+	return ok
+}
+
+// GroupBy groups the elements of 'seq' by the key returned from 'keyFn'.
+func GroupBy[K any, T comparable](seq iter.Seq[K], keyFn func(K) T) map[T][]K {
+	result := make(map[T][]K)
+	for v := range seq {
+		key := keyFn(v)
+		result[key] = append(result[key], v)
+	}
+	return result
+}
+
+// Join returns the elements of 'seq' formatted with fmt.Sprint and separated by 'sep'.
+func Join[K any](seq iter.Seq[K], sep string) string {
+	var b strings.Builder
+	first := true
+	for v := range seq {
+		if !first {
+			b.WriteString(sep)
+		}
+		first = false
+		fmt.Fprint(&b, v)
+	}
+	return b.String()
+}
+
+// Keys returns the keys of the pairs in 'seq'.
+func Keys[K, V any](seq iter.Seq2[K, V]) iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range seq {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns the values of the pairs in 'seq'.
+func Values[K, V any](seq iter.Seq2[K, V]) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, v := range seq {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Map2 returns a sequence of 'f' applied to each pair of 'seq'.
+func Map2[K, V, K2, V2 any](seq iter.Seq2[K, V], f func(K, V) (K2, V2)) iter.Seq2[K2, V2] {
+	return func(yield func(K2, V2) bool) {
+		for k, v := range seq {
+			if !yield(f(k, v)) {
+				return
+			}
+		}
+	}
+}
+
+// Filter2 returns a sequence of the pairs of 'seq' that satisfy the predicate 'f'.
+func Filter2[K, V any](seq iter.Seq2[K, V], f func(K, V) bool) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range seq {
+			if f(k, v) && !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// ToMap collects the pairs of 'seq' into a map; later pairs overwrite earlier ones with the
+// same key.
+func ToMap[K comparable, V any](seq iter.Seq2[K, V]) map[K]V {
+	result := make(map[K]V)
+	for k, v := range seq {
+		result[k] = v
+	}
+	return result
+}
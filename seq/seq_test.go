@@ -0,0 +1,178 @@
+package seq_test
+
+import (
+	"bufio"
+	"iter"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/kishankumarhs/fnkit/seq"
+)
+
+// naturals yields 0, 1, 2, ... forever, recording how many values were produced.
+func naturals(produced *int) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for i := 0; ; i++ {
+			*produced++
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+func TestLazyPipeline(t *testing.T) {
+	produced := 0
+	evens := seq.Filter(naturals(&produced), func(i int) bool { return i%2 == 0 })
+	squares := seq.Map(evens, func(i int) int { return i * i })
+	got := seq.Collect(seq.Take(squares, 4))
+	if !reflect.DeepEqual(got, []int{0, 4, 16, 36}) {
+		t.Errorf("pipeline = %v", got)
+	}
+	if produced != 7 {
+		t.Errorf("source produced %d values, want 7 (short-circuit)", produced)
+	}
+
+	produced = 0
+	if v, ok := seq.Find(naturals(&produced), func(i int) bool { return i > 2 }); v != 3 || !ok {
+		t.Errorf("Find = %d, %v", v, ok)
+	}
+	if produced != 4 {
+		t.Errorf("Find consumed %d values, want 4", produced)
+	}
+	if seq.Every(naturals(&produced), func(i int) bool { return i < 10 }) {
+		t.Error("Every over naturals < 10 = true")
+	}
+	if !seq.Any(naturals(&produced), func(i int) bool { return i == 5 }) {
+		t.Error("Any == 5 = false")
+	}
+}
+
+func TestBridges(t *testing.T) {
+	if got := seq.Collect(seq.FromSlice([]string{"a", "b"})); !reflect.DeepEqual(got, []string{"a", "b"}) {
+		t.Errorf("Collect(FromSlice) = %v", got)
+	}
+	if got := seq.Collect(seq.Of[int]()); got != nil {
+		t.Errorf("Collect(empty) = %v", got)
+	}
+	m := map[string]int{"a": 1, "b": 2}
+	keys := slices.Sorted(seq.Keys(maps.All(m)))
+	if !reflect.DeepEqual(keys, []string{"a", "b"}) {
+		t.Errorf("Keys = %v", keys)
+	}
+	doubled := seq.ToMap(seq.Map2(maps.All(m), func(k string, v int) (string, int) { return k, v * 2 }))
+	if !reflect.DeepEqual(doubled, map[string]int{"a": 2, "b": 4}) {
+		t.Errorf("Map2 = %v", doubled)
+	}
+	big := seq.ToMap(seq.Filter2(maps.All(m), func(_ string, v int) bool { return v > 1 }))
+	if !reflect.DeepEqual(big, map[string]int{"b": 2}) {
+		t.Errorf("Filter2 = %v", big)
+	}
+	if got := slices.Collect(seq.Values(maps.All(map[int]string{1: "x"}))); !reflect.DeepEqual(got, []string{"x"}) {
+		t.Errorf("Values = %v", got)
+	}
+}
+
+func TestScannerLines(t *testing.T) {
+	lines := func(s string) iter.Seq[string] {
+		return func(yield func(string) bool) {
+			sc := bufio.NewScanner(strings.NewReader(s))
+			for sc.Scan() {
+				if !yield(sc.Text()) {
+					return
+				}
+			}
+		}
+	}
+	input := "b\n\na\nb\nc\n"
+	nonEmpty := seq.Filter(lines(input), func(l string) bool { return l != "" })
+	if got := seq.Join(seq.Unique(nonEmpty), ","); got != "b,a,c" {
+		t.Errorf("Join(Unique) = %q", got)
+	}
+	groups := seq.GroupBy(lines(input), func(l string) bool { return l == "" })
+	if len(groups[true]) != 1 || len(groups[false]) != 4 {
+		t.Errorf("GroupBy = %v", groups)
+	}
+	if n := seq.Count(lines(input)); n != 5 {
+		t.Errorf("Count = %d", n)
+	}
+}
+
+func TestChunkWindow(t *testing.T) {
+	s := seq.Of(1, 2, 3, 4, 5)
+	if got := seq.Collect(seq.Chunk(s, 2)); !reflect.DeepEqual(got, [][]int{{1, 2}, {3, 4}, {5}}) {
+		t.Errorf("Chunk = %v", got)
+	}
+	if got := seq.Collect(seq.Chunk(s, 0)); got != nil {
+		t.Errorf("Chunk(0) = %v", got)
+	}
+	cases := []struct {
+		size, step int
+		want       [][]int
+	}{
+		{2, 1, [][]int{{1, 2}, {2, 3}, {3, 4}, {4, 5}}},
+		{3, 2, [][]int{{1, 2, 3}, {3, 4, 5}}},
+		{2, 3, [][]int{{1, 2}, {4, 5}}},
+		{6, 1, nil},
+	}
+	for _, c := range cases {
+		if got := seq.Collect(seq.Window(s, c.size, c.step)); !reflect.DeepEqual(got, c.want) {
+			t.Errorf("Window(%d, %d) = %v, want %v", c.size, c.step, got, c.want)
+		}
+	}
+}
+
+func TestTakeDropConcatFlatMap(t *testing.T) {
+	s := seq.Of(1, 2, 3, 4, 1)
+	small := func(i int) bool { return i < 3 }
+	cases := []struct {
+		name string
+		got  []int
+		want []int
+	}{
+		{"Take", seq.Collect(seq.Take(s, 2)), []int{1, 2}},
+		{"Take(0)", seq.Collect(seq.Take(s, 0)), nil},
+		{"Drop", seq.Collect(seq.Drop(s, 3)), []int{4, 1}},
+		{"TakeWhile", seq.Collect(seq.TakeWhile(s, small)), []int{1, 2}},
+		{"DropWhile", seq.Collect(seq.DropWhile(s, small)), []int{3, 4, 1}},
+		{"Concat", seq.Collect(seq.Concat(seq.Of(1), seq.Of[int](), seq.Of(2, 3))), []int{1, 2, 3}},
+		{"FlatMap", seq.Collect(seq.FlatMap(seq.Of(1, 2), func(i int) iter.Seq[int] { return seq.Of(i, i*10) })), []int{1, 10, 2, 20}},
+		{"Scan", seq.Collect(seq.Scan(s, 0, func(acc, v int) int { return acc + v })), []int{1, 3, 6, 10, 11}},
+		{"UniqueBy", seq.Collect(seq.UniqueBy(s, func(i int) bool { return i%2 == 0 })), []int{1, 2}},
+	}
+	for _, c := range cases {
+		if !reflect.DeepEqual(c.got, c.want) {
+			t.Errorf("%s = %v, want %v", c.name, c.got, c.want)
+		}
+	}
+	if got := seq.Reduce(s, 0, func(acc, v int) int { return acc + v }); got != 11 {
+		t.Errorf("Reduce = %d", got)
+	}
+	if seq.IndexOf(s, 4) != 3 || seq.IndexOf(s, 9) != -1 || !seq.Includes(s, 3) {
+		t.Error("IndexOf/Includes gave the wrong answer")
+	}
+}
+
+func TestEnumerateZip(t *testing.T) {
+	var idx []int
+	for i, v := range seq.Enumerate(seq.Of("a", "b")) {
+		idx = append(idx, i)
+		_ = v
+	}
+	if !reflect.DeepEqual(idx, []int{0, 1}) {
+		t.Errorf("Enumerate indexes = %v", idx)
+	}
+	produced := 0
+	pairs := seq.ToMap(seq.Zip(seq.Of("a", "b", "c"), naturals(&produced)))
+	if !reflect.DeepEqual(pairs, map[string]int{"a": 0, "b": 1, "c": 2}) {
+		t.Errorf("Zip = %v", pairs)
+	}
+	sum := 0
+	seq.ForEach(seq.Of(1, 2, 3), func(i int) { sum += i })
+	if sum != 6 {
+		t.Errorf("ForEach sum = %d", sum)
+	}
+}
@@ -0,0 +1,100 @@
+package fnkit
+
+// The set operations below treat slices as ordered sets: results contain no duplicates and
+// keep the order in which elements are first seen, reading 'a' before 'b'. The ...By
+// variants compare elements by the key returned from 'keyFn' and keep the first element
+// seen for each key.
+
+func identity[K any](v K) K { return v }
+
+// keySet returns the set of keys of the elements of 's'.
+func keySet[K any, T comparable](s []K, keyFn func(K) T) map[T]struct{} {
+	set := make(map[T]struct{}, len(s))
+	for _, v := range s {
+		set[keyFn(v)] = struct{}{}
+	}
+	return set
+}
+
+// UniqueBy returns the elements of the slice 's' with duplicate keys removed, keeping the
+// first element for each key.
+func UniqueBy[K any, T comparable](s []K, keyFn func(K) T) []K {
+	seen := make(map[T]struct{})
+	var result []K
+	for _, v := range s {
+		k := keyFn(v)
+		if _, ok := seen[k]; !ok {
+			seen[k] = struct{}{}
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// Intersect returns the distinct elements of 'a' that are also in 'b'.
+func Intersect[K comparable](a, b []K) []K {
+	return IntersectBy(a, b, identity[K])
+}
+
+// IntersectBy returns the elements of 'a' whose key is also the key of an element of 'b'.
+func IntersectBy[K any, T comparable](a, b []K, keyFn func(K) T) []K {
+	inB := keySet(b, keyFn)
+	return UniqueBy(ToFilter(a, func(v K) bool {
+		_, ok := inB[keyFn(v)]
+		return ok
+	}), keyFn)
+}
+
+// Difference returns the distinct elements of 'a' that are not in 'b'.
+func Difference[K comparable](a, b []K) []K {
+	return DifferenceBy(a, b, identity[K])
+}
+
+// DifferenceBy returns the elements of 'a' whose key is not the key of any element of 'b'.
+func DifferenceBy[K any, T comparable](a, b []K, keyFn func(K) T) []K {
+	inB := keySet(b, keyFn)
+	return UniqueBy(ToFilter(a, func(v K) bool {
+		_, ok := inB[keyFn(v)]
+		return !ok
+	}), keyFn)
+}
+
+// Union returns the distinct elements of 'a' followed by those of 'b' not already in 'a'.
+func Union[K comparable](a, b []K) []K {
+	return UnionBy(a, b, identity[K])
+}
+
+// UnionBy returns the elements of 'a' and 'b' with duplicate keys removed.
+func UnionBy[K any, T comparable](a, b []K, keyFn func(K) T) []K {
+	return UniqueBy(Concat(a, b), keyFn)
+}
+
+// SymmetricDifference returns the distinct elements that are in exactly one of 'a' and 'b':
+// those only in 'a' first, then those only in 'b'.
+func SymmetricDifference[K comparable](a, b []K) []K {
+	return SymmetricDifferenceBy(a, b, identity[K])
+}
+
+// SymmetricDifferenceBy returns the elements whose key appears in exactly one of 'a' and 'b'.
+func SymmetricDifferenceBy[K any, T comparable](a, b []K, keyFn func(K) T) []K {
+	return Concat(DifferenceBy(a, b, keyFn), DifferenceBy(b, a, keyFn))
+}
+
+// IsSubset reports whether every element of 'a' is also in 'b'. An empty 'a' is a subset
+// of anything.
+func IsSubset[K comparable](a, b []K) bool {
+	inB := keySet(b, identity[K])
+	return Every(a, func(v K) bool {
+		_, ok := inB[v]
+		return ok
+	})
+}
+
+// Disjoint reports whether 'a' and 'b' have no elements in common.
+func Disjoint[K comparable](a, b []K) bool {
+	inB := keySet(b, identity[K])
+	return !Any(a, func(v K) bool {
+		_, ok := inB[v]
+		return ok
+	})
+}
@@ -0,0 +1,74 @@
+package fnkit
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSetOperations(t *testing.T) {
+	a := []int{3, 1, 2, 3, 4}
+	b := []int{4, 5, 3, 5}
+	cases := []struct {
+		name string
+		got  []int
+		want []int
+	}{
+		{"Intersect", Intersect(a, b), []int{3, 4}},
+		{"Difference", Difference(a, b), []int{1, 2}},
+		{"Union", Union(a, b), []int{3, 1, 2, 4, 5}},
+		{"SymmetricDifference", SymmetricDifference(a, b), []int{1, 2, 5}},
+		{"Intersect empty", Intersect(a, nil), nil},
+		{"Difference empty", Difference(a, nil), []int{3, 1, 2, 4}},
+	}
+	for _, c := range cases {
+		if !reflect.DeepEqual(c.got, c.want) {
+			t.Errorf("%s = %v, want %v", c.name, c.got, c.want)
+		}
+	}
+}
+
+func TestSetOperationsBy(t *testing.T) {
+	type account struct {
+		ID     string
+		Source string
+	}
+	crm := []account{{"a1", "crm"}, {"a2", "crm"}, {"a3", "crm"}, {"a2", "crm-dup"}}
+	billing := []account{{"a2", "billing"}, {"a4", "billing"}}
+	id := func(a account) string { return a.ID }
+
+	if got := IntersectBy(crm, billing, id); !reflect.DeepEqual(got, []account{{"a2", "crm"}}) {
+		t.Errorf("IntersectBy = %v", got)
+	}
+	if got := DifferenceBy(crm, billing, id); !reflect.DeepEqual(got, []account{{"a1", "crm"}, {"a3", "crm"}}) {
+		t.Errorf("DifferenceBy = %v", got)
+	}
+	wantUnion := []account{{"a1", "crm"}, {"a2", "crm"}, {"a3", "crm"}, {"a4", "billing"}}
+	if got := UnionBy(crm, billing, id); !reflect.DeepEqual(got, wantUnion) {
+		t.Errorf("UnionBy = %v", got)
+	}
+	wantSym := []account{{"a1", "crm"}, {"a3", "crm"}, {"a4", "billing"}}
+	if got := SymmetricDifferenceBy(crm, billing, id); !reflect.DeepEqual(got, wantSym) {
+		t.Errorf("SymmetricDifferenceBy = %v", got)
+	}
+	if got := UniqueBy(crm, id); len(got) != 3 || got[1].Source != "crm" {
+		t.Errorf("UniqueBy = %v", got)
+	}
+}
+
+func TestIsSubsetAndDisjoint(t *testing.T) {
+	if !IsSubset([]int{1, 2, 2}, []int{3, 2, 1}) {
+		t.Error("IsSubset([1 2 2], [3 2 1]) = false")
+	}
+	if IsSubset([]int{1, 4}, []int{1, 2}) {
+		t.Error("IsSubset([1 4], [1 2]) = true")
+	}
+	if !IsSubset(nil, []int{1}) {
+		t.Error("IsSubset(nil, [1]) = false")
+	}
+	if !Disjoint([]string{"a", "b"}, []string{"c"}) || Disjoint([]string{"a", "b"}, []string{"b"}) {
+		t.Error("Disjoint gave the wrong answer")
+	}
+	if !Disjoint[int](nil, nil) {
+		t.Error("Disjoint(nil, nil) = false")
+	}
+}
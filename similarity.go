@@ -0,0 +1,266 @@
+package fnkit
+
+import "sort"
+
+// Levenshtein returns the edit distance between a and b: the minimum number of single-rune
+// insertions, deletions and substitutions needed to turn a into b.
+func Levenshtein(a, b string) int {
+	d, _ := levenshtein([]rune(a), []rune(b), -1)
+	return d
+}
+
+// LevenshteinWithin reports whether the edit distance between a and b is at most limit,
+// returning the distance when it is. It stops as soon as every path exceeds limit, which makes
+// it much cheaper than Levenshtein for rejecting dissimilar strings.
+func LevenshteinWithin(a, b string, limit int) (int, bool) {
+	if limit < 0 {
+		return 0, false
+	}
+	return levenshtein([]rune(a), []rune(b), limit)
+}
+
+// levenshtein computes the edit distance row by row; a negative limit disables the early exit.
+func levenshtein(a, b []rune, limit int) (int, bool) {
+	if len(a) < len(b) {
+		a, b = b, a
+	}
+	if limit >= 0 && len(a)-len(b) > limit {
+		return 0, false
+	}
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		rowMin := curr[0]
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+			rowMin = min(rowMin, curr[j])
+		}
+		if limit >= 0 && rowMin > limit {
+			return 0, false
+		}
+		prev, curr = curr, prev
+	}
+	d := prev[len(b)]
+	if limit >= 0 && d > limit {
+		return 0, false
+	}
+	return d, true
+}
+
+// DamerauLevenshtein returns the edit distance between a and b where, in addition to
+// insertions, deletions and substitutions, swapping two adjacent runes counts as one edit
+// ("teh" → "the" is 1). Transposed runes may be edited further, as in the unrestricted
+// Damerau-Levenshtein distance.
+func DamerauLevenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	maxDist := len(ra) + len(rb)
+	// d is offset by one row and column so that index -1 holds maxDist.
+	d := make([][]int, len(ra)+2)
+	for i := range d {
+		d[i] = make([]int, len(rb)+2)
+	}
+	d[0][0] = maxDist
+	for i := 0; i <= len(ra); i++ {
+		d[i+1][0] = maxDist
+		d[i+1][1] = i
+	}
+	for j := 0; j <= len(rb); j++ {
+		d[0][j+1] = maxDist
+		d[1][j+1] = j
+	}
+	lastRow := make(map[rune]int)
+	for i := 1; i <= len(ra); i++ {
+		lastCol := 0
+		for j := 1; j <= len(rb); j++ {
+			k := lastRow[rb[j-1]]
+			l := lastCol
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+				lastCol = j
+			}
+			d[i+1][j+1] = min(
+				d[i][j]+cost,
+				d[i+1][j]+1,
+				d[i][j+1]+1,
+				d[k][l]+(i-k-1)+1+(j-l-1),
+			)
+		}
+		lastRow[ra[i-1]] = i
+	}
+	return d[len(ra)+1][len(rb)+1]
+}
+
+// Jaro returns the Jaro similarity of a and b, between 0 (nothing in common) and 1 (equal).
+func Jaro(a, b string) float64 {
+	ra, rb := []rune(a), []rune(b)
+	if len(ra) == 0 && len(rb) == 0 {
+		return 1
+	}
+	if len(ra) == 0 || len(rb) == 0 {
+		return 0
+	}
+	window := max(len(ra), len(rb))/2 - 1
+	if window < 0 {
+		window = 0
+	}
+	matchedA := make([]bool, len(ra))
+	matchedB := make([]bool, len(rb))
+	matches := 0
+	for i, r := range ra {
+		lo, hi := max(0, i-window), min(len(rb)-1, i+window)
+		for j := lo; j <= hi; j++ {
+			if !matchedB[j] && rb[j] == r {
+				matchedA[i], matchedB[j] = true, true
+				matches++
+				break
+			}
+		}
+	}
+	if matches == 0 {
+		return 0
+	}
+	transpositions := 0
+	j := 0
+	for i := range ra {
+		if !matchedA[i] {
+			continue
+		}
+		for !matchedB[j] {
+			j++
+		}
+		if ra[i] != rb[j] {
+			transpositions++
+		}
+		j++
+	}
+	m := float64(matches)
+	return (m/float64(len(ra)) + m/float64(len(rb)) + (m-float64(transpositions)/2)/m) / 3
+}
+
+// JaroWinkler returns the Jaro-Winkler similarity of a and b, between 0 and 1. It boosts the
+// Jaro similarity of strings that share a prefix of up to four runes, which suits short
+// identifiers such as command names.
+func JaroWinkler(a, b string) float64 {
+	sim := Jaro(a, b)
+	ra, rb := []rune(a), []rune(b)
+	prefix := 0
+	for prefix < min(4, len(ra), len(rb)) && ra[prefix] == rb[prefix] {
+		prefix++
+	}
+	return sim + float64(prefix)*0.1*(1-sim)
+}
+
+// TrigramSimilarity returns the Jaccard similarity of the rune trigrams of a and b, between
+// 0 and 1. Both strings are padded with two spaces in front and one behind so that short
+// strings and word starts carry weight.
+func TrigramSimilarity(a, b string) float64 {
+	ga, gb := ngramSet("  "+a+" ", 3), ngramSet("  "+b+" ", 3)
+	if len(ga) == 0 && len(gb) == 0 {
+		return 1
+	}
+	shared := 0
+	for g := range ga {
+		if _, ok := gb[g]; ok {
+			shared++
+		}
+	}
+	return float64(shared) / float64(len(ga)+len(gb)-shared)
+}
+
+// DiceCoefficient returns the Sørensen-Dice similarity of the rune bigrams of a and b,
+// between 0 and 1. Repeated bigrams are counted as often as they occur.
+func DiceCoefficient(a, b string) float64 {
+	ra, rb := []rune(a), []rune(b)
+	if len(ra) < 2 || len(rb) < 2 {
+		if string(ra) == string(rb) {
+			return 1
+		}
+		return 0
+	}
+	counts := make(map[[2]rune]int, len(ra)-1)
+	for i := 0; i+1 < len(ra); i++ {
+		counts[[2]rune{ra[i], ra[i+1]}]++
+	}
+	shared := 0
+	for i := 0; i+1 < len(rb); i++ {
+		bg := [2]rune{rb[i], rb[i+1]}
+		if counts[bg] > 0 {
+			counts[bg]--
+			shared++
+		}
+	}
+	return 2 * float64(shared) / float64(len(ra)+len(rb)-2)
+}
+
+func ngramSet(s string, n int) map[string]struct{} {
+	runes := []rune(s)
+	set := make(map[string]struct{})
+	for i := 0; i+n <= len(runes); i++ {
+		set[string(runes[i:i+n])] = struct{}{}
+	}
+	return set
+}
+
+// LongestCommonSubsequence returns the longest sequence of runes that appears in both a and b
+// in the same order, though not necessarily contiguously.
+func LongestCommonSubsequence(a, b string) string {
+	ra, rb := []rune(a), []rune(b)
+	lengths := make([][]int, len(ra)+1)
+	for i := range lengths {
+		lengths[i] = make([]int, len(rb)+1)
+	}
+	for i := len(ra) - 1; i >= 0; i-- {
+		for j := len(rb) - 1; j >= 0; j-- {
+			if ra[i] == rb[j] {
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			} else {
+				lengths[i][j] = max(lengths[i+1][j], lengths[i][j+1])
+			}
+		}
+	}
+	out := make([]rune, 0, lengths[0][0])
+	for i, j := 0, 0; i < len(ra) && j < len(rb); {
+		switch {
+		case ra[i] == rb[j]:
+			out = append(out, ra[i])
+			i++
+			j++
+		case lengths[i+1][j] >= lengths[i][j+1]:
+			i++
+		default:
+			j++
+		}
+	}
+	return string(out)
+}
+
+// Match is a candidate string ranked by ClosestMatch.
+type Match struct {
+	Value string
+	Index int     // position of Value in the candidates slice
+	Score float64 // Jaro-Winkler similarity to the query, between 0 and 1
+}
+
+// ClosestMatch ranks candidates by Jaro-Winkler similarity to query and returns the best k,
+// highest score first. Ties keep the order of candidates. If k <= 0 or exceeds the number of
+// candidates, all candidates are returned.
+func ClosestMatch(query string, candidates []string, k int) []Match {
+	matches := make([]Match, len(candidates))
+	for i, c := range candidates {
+		matches[i] = Match{Value: c, Index: i, Score: JaroWinkler(query, c)}
+	}
+	sort.SliceStable(matches, func(i, j int) bool { return matches[i].Score > matches[j].Score })
+	if k > 0 && k < len(matches) {
+		matches = matches[:k]
+	}
+	return matches
+}
@@ -0,0 +1,104 @@
+package fnkit
+
+import (
+	"math"
+	"testing"
+)
+
+func TestLevenshtein(t *testing.T) {
+	cases := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"abc", "", 3},
+		{"kitten", "sitting", 3},
+		{"flaw", "lawn", 2},
+		{"héllo", "hello", 1},
+	}
+	for _, c := range cases {
+		if got := Levenshtein(c.a, c.b); got != c.want {
+			t.Errorf("Levenshtein(%q, %q) = %d, want %d", c.a, c.b, got, c.want)
+		}
+	}
+	if d, ok := LevenshteinWithin("kitten", "sitting", 3); !ok || d != 3 {
+		t.Errorf("LevenshteinWithin() = %d, %v", d, ok)
+	}
+	if _, ok := LevenshteinWithin("kitten", "sitting", 2); ok {
+		t.Error("LevenshteinWithin() should exceed limit")
+	}
+	if _, ok := LevenshteinWithin("a", "abcdef", 2); ok {
+		t.Error("LevenshteinWithin() should reject by length")
+	}
+}
+
+func TestDamerauLevenshtein(t *testing.T) {
+	cases := []struct {
+		a, b string
+		want int
+	}{
+		{"teh", "the", 1},
+		{"ca", "abc", 2},
+		{"kitten", "sitting", 3},
+		{"", "ab", 2},
+	}
+	for _, c := range cases {
+		if got := DamerauLevenshtein(c.a, c.b); got != c.want {
+			t.Errorf("DamerauLevenshtein(%q, %q) = %d, want %d", c.a, c.b, got, c.want)
+		}
+	}
+}
+
+func TestJaroWinkler(t *testing.T) {
+	approx := func(got, want float64) bool { return math.Abs(got-want) < 0.001 }
+	if got := Jaro("MARTHA", "MARHTA"); !approx(got, 0.944) {
+		t.Errorf("Jaro() = %f", got)
+	}
+	if got := JaroWinkler("MARTHA", "MARHTA"); !approx(got, 0.961) {
+		t.Errorf("JaroWinkler() = %f", got)
+	}
+	if got := JaroWinkler("DIXON", "DICKSONX"); !approx(got, 0.813) {
+		t.Errorf("JaroWinkler() = %f", got)
+	}
+	if got := JaroWinkler("abc", "xyz"); got != 0 {
+		t.Errorf("JaroWinkler() disjoint = %f", got)
+	}
+}
+
+func TestNGramSimilarity(t *testing.T) {
+	if got := TrigramSimilarity("hello", "hello"); got != 1 {
+		t.Errorf("TrigramSimilarity() equal = %f", got)
+	}
+	if got := TrigramSimilarity("hello", "xyz"); got != 0 {
+		t.Errorf("TrigramSimilarity() disjoint = %f", got)
+	}
+	if a, b := TrigramSimilarity("commit", "comit"), TrigramSimilarity("commit", "status"); a <= b {
+		t.Errorf("TrigramSimilarity() ranking: %f <= %f", a, b)
+	}
+	if got := DiceCoefficient("night", "nacht"); got != 0.25 {
+		t.Errorf("DiceCoefficient() = %f", got)
+	}
+}
+
+func TestLongestCommonSubsequence(t *testing.T) {
+	if got := LongestCommonSubsequence("ABCBDAB", "BDCABA"); len(got) != 4 {
+		t.Errorf("LongestCommonSubsequence() = %q", got)
+	}
+	if got := LongestCommonSubsequence("abc", "xyz"); got != "" {
+		t.Errorf("LongestCommonSubsequence() disjoint = %q", got)
+	}
+}
+
+func TestClosestMatch(t *testing.T) {
+	commands := []string{"status", "commit", "checkout", "push", "pull"}
+	got := ClosestMatch("comit", commands, 2)
+	if len(got) != 2 || got[0].Value != "commit" || got[0].Index != 1 {
+		t.Fatalf("ClosestMatch() = %+v", got)
+	}
+	if got[0].Score < got[1].Score {
+		t.Errorf("ClosestMatch() not sorted: %+v", got)
+	}
+	if all := ClosestMatch("x", commands, 0); len(all) != len(commands) {
+		t.Errorf("ClosestMatch() k=0 returned %d", len(all))
+	}
+}
@@ -454,3 +454,140 @@ func ToFilter[K any](s []K, f func(K) bool) []K {
 	}
 	return result
 }
+
+// Pair holds two values of possibly different types, as produced by Zip and Pairwise.
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// Triple holds three values of possibly different types, as produced by Zip3.
+type Triple[A, B, C any] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+// Zip pairs up the elements of 'a' and 'b' by position. The result is as long as the
+// shorter slice; extra elements are dropped.
+func Zip[A, B any](a []A, b []B) []Pair[A, B] {
+	result := make([]Pair[A, B], min(len(a), len(b)))
+	for i := range result {
+		result[i] = Pair[A, B]{First: a[i], Second: b[i]}
+	}
+	return result
+}
+
+// Zip3 groups the elements of 'a', 'b' and 'c' by position. The result is as long as the
+// shortest slice.
+func Zip3[A, B, C any](a []A, b []B, c []C) []Triple[A, B, C] {
+	result := make([]Triple[A, B, C], min(len(a), len(b), len(c)))
+	for i := range result {
+		result[i] = Triple[A, B, C]{First: a[i], Second: b[i], Third: c[i]}
+	}
+	return result
+}
+
+// Unzip splits a slice of pairs into a slice of first values and a slice of second values.
+func Unzip[A, B any](pairs []Pair[A, B]) ([]A, []B) {
+	as := make([]A, len(pairs))
+	bs := make([]B, len(pairs))
+	for i, p := range pairs {
+		as[i], bs[i] = p.First, p.Second
+	}
+	return as, bs
+}
+
+// PartitionFunc splits the slice 's' into the elements that satisfy the predicate 'f' and
+// those that do not, keeping their order. Unlike ToFilter, nothing is thrown away.
+func PartitionFunc[K any](s []K, f func(K) bool) (yes, no []K) {
+	for _, v := range s {
+		if f(v) {
+			yes = append(yes, v)
+		} else {
+			no = append(no, v)
+		}
+	}
+	return yes, no
+}
+
+// Window returns the sliding windows of 'size' consecutive elements of the slice 's',
+// starting every 'step' elements. Only full windows are returned, so Window([1,2,3,4], 2, 1)
+// is [[1,2],[2,3],[3,4]]. Unlike Chunk, windows overlap when step < size. Windows share
+// memory with 's' but cannot be appended into it. It returns nil if size or step is not
+// positive.
+func Window[K any](s []K, size, step int) [][]K {
+	if size <= 0 || step <= 0 {
+		return nil
+	}
+	var result [][]K
+	for i := 0; i+size <= len(s); i += step {
+		result = append(result, s[i:i+size:i+size])
+	}
+	return result
+}
+
+// Pairwise returns each element of the slice 's' paired with the one after it:
+// Pairwise([1,2,3]) is [{1,2},{2,3}]. Slices shorter than two elements give an empty result.
+func Pairwise[K any](s []K) []Pair[K, K] {
+	if len(s) < 2 {
+		return []Pair[K, K]{}
+	}
+	result := make([]Pair[K, K], len(s)-1)
+	for i := range result {
+		result[i] = Pair[K, K]{First: s[i], Second: s[i+1]}
+	}
+	return result
+}
+
+// Interleave takes one element from each slice in turn until all are exhausted:
+// Interleave([1,2,3], [10], [20,21]) is [1,10,20,2,21,3].
+func Interleave[K any](slices ...[]K) []K {
+	total, longest := 0, 0
+	for _, s := range slices {
+		total += len(s)
+		longest = max(longest, len(s))
+	}
+	result := make([]K, 0, total)
+	for i := 0; i < longest; i++ {
+		for _, s := range slices {
+			if i < len(s) {
+				result = append(result, s[i])
+			}
+		}
+	}
+	return result
+}
+
+// Scan is like Reduce, but returns every intermediate accumulator rather than only the last
+// one: Scan([1,2,3], 0, add) is [1,3,6]. The initial value itself is not included.
+func Scan[K any, T any](s []K, initial T, f func(T, K) T) []T {
+	result := make([]T, len(s))
+	accumulator := initial
+	for i, v := range s {
+		accumulator = f(accumulator, v)
+		result[i] = accumulator
+	}
+	return result
+}
+
+// Enumerate is like Entries, but numbers the elements from 'start' instead of 0.
+func Enumerate[K any](s []K, start int) []Entry[K] {
+	result := make([]Entry[K], len(s))
+	for i, v := range s {
+		result[i] = Entry[K]{Index: start + i, Value: v}
+	}
+	return result
+}
+
+// Associate builds a map from the slice 's' using the key and value returned by 'f' for
+// each element. When two elements produce the same key, the later one wins; use GroupBy to
+// keep them all.
+func Associate[K any, M comparable, V any](s []K, f func(K) (M, V)) map[M]V {
+	result := make(map[M]V, len(s))
+	for _, v := range s {
+		k, val := f(v)
+		result[k] = val
+	}
+	return result
+}
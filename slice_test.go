@@ -0,0 +1,95 @@
+package fnkit
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestZipUnzip(t *testing.T) {
+	names := []string{"a", "b", "c"}
+	ages := []int{1, 2}
+	pairs := Zip(names, ages)
+	if want := []Pair[string, int]{{"a", 1}, {"b", 2}}; !reflect.DeepEqual(pairs, want) {
+		t.Errorf("Zip = %v, want %v", pairs, want)
+	}
+	gotNames, gotAges := Unzip(pairs)
+	if !reflect.DeepEqual(gotNames, []string{"a", "b"}) || !reflect.DeepEqual(gotAges, []int{1, 2}) {
+		t.Errorf("Unzip = %v, %v", gotNames, gotAges)
+	}
+	triples := Zip3(names, ages, []bool{true, false, true})
+	if want := []Triple[string, int, bool]{{"a", 1, true}, {"b", 2, false}}; !reflect.DeepEqual(triples, want) {
+		t.Errorf("Zip3 = %v, want %v", triples, want)
+	}
+	if got := Zip([]int{}, names); len(got) != 0 {
+		t.Errorf("Zip with empty = %v", got)
+	}
+}
+
+func TestPartitionFunc(t *testing.T) {
+	even, odd := PartitionFunc([]int{1, 2, 3, 4, 5}, func(i int) bool { return i%2 == 0 })
+	if !reflect.DeepEqual(even, []int{2, 4}) || !reflect.DeepEqual(odd, []int{1, 3, 5}) {
+		t.Errorf("PartitionFunc = %v, %v", even, odd)
+	}
+}
+
+func TestWindow(t *testing.T) {
+	s := []int{1, 2, 3, 4, 5}
+	cases := []struct {
+		size, step int
+		want       [][]int
+	}{
+		{2, 1, [][]int{{1, 2}, {2, 3}, {3, 4}, {4, 5}}},
+		{3, 2, [][]int{{1, 2, 3}, {3, 4, 5}}},
+		{2, 3, [][]int{{1, 2}, {4, 5}}},
+		{6, 1, nil},
+		{0, 1, nil},
+		{2, 0, nil},
+	}
+	for _, c := range cases {
+		if got := Window(s, c.size, c.step); !reflect.DeepEqual(got, c.want) {
+			t.Errorf("Window(%d, %d) = %v, want %v", c.size, c.step, got, c.want)
+		}
+	}
+	w := Window(s, 2, 1)
+	w[0] = append(w[0], 99)
+	if s[2] != 3 {
+		t.Errorf("appending to a window overwrote the source: %v", s)
+	}
+}
+
+func TestPairwiseAndInterleave(t *testing.T) {
+	if got := Pairwise([]int{1, 2, 3}); !reflect.DeepEqual(got, []Pair[int, int]{{1, 2}, {2, 3}}) {
+		t.Errorf("Pairwise = %v", got)
+	}
+	if got := Pairwise([]int{1}); len(got) != 0 {
+		t.Errorf("Pairwise of one = %v", got)
+	}
+	if got := Interleave([]int{1, 2, 3}, []int{10}, []int{20, 21}); !reflect.DeepEqual(got, []int{1, 10, 20, 2, 21, 3}) {
+		t.Errorf("Interleave = %v", got)
+	}
+	if got := Interleave[int](); len(got) != 0 {
+		t.Errorf("Interleave() = %v", got)
+	}
+}
+
+func TestScanEnumerateAssociate(t *testing.T) {
+	sums := Scan([]int{1, 2, 3, 4}, 0, func(acc, v int) int { return acc + v })
+	if !reflect.DeepEqual(sums, []int{1, 3, 6, 10}) {
+		t.Errorf("Scan = %v", sums)
+	}
+	if got := Scan([]int{}, 5, func(acc, v int) int { return acc + v }); len(got) != 0 {
+		t.Errorf("Scan of empty = %v", got)
+	}
+	if got := Enumerate([]string{"a", "b"}, 1); !reflect.DeepEqual(got, []Entry[string]{{1, "a"}, {2, "b"}}) {
+		t.Errorf("Enumerate = %v", got)
+	}
+	byLen := Associate([]string{"a", "bb", "cc"}, func(s string) (int, string) { return len(s), s })
+	if want := map[int]string{1: "a", 2: "cc"}; !reflect.DeepEqual(byLen, want) {
+		t.Errorf("Associate = %v, want %v", byLen, want)
+	}
+	index := Associate([]int{7, 8}, func(i int) (string, int) { return strconv.Itoa(i), i * i })
+	if index["8"] != 64 {
+		t.Errorf("Associate = %v", index)
+	}
+}
@@ -0,0 +1,206 @@
+package fnkit
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// SlugOptions configures Slugify. The zero value produces lowercase, hyphen-separated
+// slugs of unlimited length.
+type SlugOptions struct {
+	Separator string // placed between words; defaults to "-"
+	MaxLength int    // maximum length in bytes, cut at a word boundary; 0 means no limit
+	KeepCase  bool   // keep the original letter case instead of lowercasing
+}
+
+// Slugify converts s into a URL-safe slug such as "creme-brulee-recipe".
+// Latin diacritics, Cyrillic and Greek are transliterated to ASCII, apostrophes are
+// dropped, and every other run of non-alphanumeric characters becomes one separator.
+// Characters that cannot be transliterated are removed. When MaxLength is set, whole
+// words are dropped from the end until the slug fits; a single word longer than
+// MaxLength is cut.
+func Slugify(s string, opts SlugOptions) string {
+	sep := opts.Separator
+	if sep == "" {
+		sep = "-"
+	}
+	s = Transliterate(s)
+	if !opts.KeepCase {
+		s = strings.ToLower(s)
+	}
+	words := strings.FieldsFunc(Remove(s, isApostrophe), func(r rune) bool {
+		return r >= utf8.RuneSelf || !(unicode.IsLetter(r) || unicode.IsDigit(r))
+	})
+	slug := strings.Join(words, sep)
+	if opts.MaxLength <= 0 || len(slug) <= opts.MaxLength {
+		return slug
+	}
+	if len(words[0]) > opts.MaxLength {
+		return words[0][:opts.MaxLength]
+	}
+	slug = words[0]
+	for _, w := range words[1:] {
+		if len(slug)+len(sep)+len(w) > opts.MaxLength {
+			break
+		}
+		slug += sep + w
+	}
+	return slug
+}
+
+// UniqueSlug returns slug if exists reports it unused, and otherwise the first of
+// slug-2, slug-3, ... that is free, joined with opts.Separator. With opts.MaxLength set,
+// slug is shortened as needed so that the suffixed candidate still fits.
+func UniqueSlug(slug string, opts SlugOptions, exists func(string) bool) string {
+	if !exists(slug) {
+		return slug
+	}
+	sep := opts.Separator
+	if sep == "" {
+		sep = "-"
+	}
+	for n := 2; ; n++ {
+		suffix := sep + strconv.Itoa(n)
+		base := slug
+		if opts.MaxLength > 0 && len(base)+len(suffix) > opts.MaxLength {
+			base = strings.TrimSuffix(base[:max(opts.MaxLength-len(suffix), 0)], sep)
+		}
+		candidate := base + suffix
+		if !exists(candidate) {
+			return candidate
+		}
+	}
+}
+
+// Transliterate replaces Latin letters with diacritics, Cyrillic and Greek letters with
+// their closest ASCII spelling ("Straße" → "Strasse", "Привет" → "Privet") and strips
+// combining marks from letters that end up as ASCII, or are Latin or Greek. Other
+// characters, including the vowel signs and viramas of Indic scripts and Hebrew and Arabic
+// points, are returned unchanged.
+func Transliterate(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	stripMarks := false // whether combining marks on the current base letter are dropped
+	for _, r := range s {
+		if unicode.Is(unicode.Mn, r) {
+			if !stripMarks {
+				b.WriteRune(r)
+			}
+			continue
+		}
+		if r < utf8.RuneSelf {
+			b.WriteRune(r)
+			stripMarks = true
+			continue
+		}
+		if t, ok := transliterations[r]; ok {
+			b.WriteString(t)
+			stripMarks = true
+			continue
+		}
+		b.WriteRune(r)
+		stripMarks = unicode.In(r, unicode.Latin, unicode.Greek)
+	}
+	return b.String()
+}
+
+// SafeFilename turns s into a name that is safe to use as a single path element on
+// Windows, macOS and Linux. Path separators, reserved characters (<>:"|?*) and control
+// characters are replaced with "_", leading and trailing spaces and dots are trimmed,
+// reserved device names such as "CON" or "lpt1.txt" are prefixed with "_", and the result
+// is limited to 255 bytes, keeping the extension where possible.
+func SafeFilename(s string) string {
+	s = strings.Map(func(r rune) rune {
+		if r < 0x20 || r == 0x7F || strings.ContainsRune(`/\<>:"|?*`, r) {
+			return '_'
+		}
+		return r
+	}, s)
+	s = strings.Trim(s, " .")
+	if s == "" {
+		return "_"
+	}
+	base, _, _ := strings.Cut(s, ".")
+	if _, reserved := reservedFilenames[strings.ToUpper(strings.TrimSpace(base))]; reserved {
+		s = "_" + s
+	}
+	const maxLen = 255
+	if len(s) <= maxLen {
+		return s
+	}
+	ext := ""
+	if i := strings.LastIndex(s, "."); i > 0 && len(s)-i <= 16 {
+		ext = s[i:]
+	}
+	name := s[:maxLen-len(ext)]
+	for !utf8.ValidString(name) {
+		name = name[:len(name)-1]
+	}
+	return name + ext
+}
+
+func isApostrophe(r rune) bool {
+	return r == '\'' || r == '\u2019'
+}
+
+// reservedFilenames are device names Windows refuses to use as file names, with or without
+// an extension.
+var reservedFilenames = map[string]struct{}{
+	"CON": {}, "PRN": {}, "AUX": {}, "NUL": {},
+	"COM1": {}, "COM2": {}, "COM3": {}, "COM4": {}, "COM5": {}, "COM6": {}, "COM7": {}, "COM8": {}, "COM9": {},
+	"LPT1": {}, "LPT2": {}, "LPT3": {}, "LPT4": {}, "LPT5": {}, "LPT6": {}, "LPT7": {}, "LPT8": {}, "LPT9": {},
+}
+
+// transliterations maps Latin-1 and Latin Extended-A letters, Cyrillic (Russian, Ukrainian
+// and Belarusian) and Greek letters to ASCII.
+var transliterations = map[rune]string{
+	// Latin
+	'À': "A", 'Á': "A", 'Â': "A", 'Ã': "A", 'Ä': "A", 'Å': "A", 'Æ': "AE", 'Ç': "C",
+	'È': "E", 'É': "E", 'Ê': "E", 'Ë': "E", 'Ì': "I", 'Í': "I", 'Î': "I", 'Ï': "I",
+	'Ð': "D", 'Ñ': "N", 'Ò': "O", 'Ó': "O", 'Ô': "O", 'Õ': "O", 'Ö': "O", 'Ø': "O",
+	'Ù': "U", 'Ú': "U", 'Û': "U", 'Ü': "U", 'Ý': "Y", 'Þ': "TH", 'ß': "ss", 'à': "a",
+	'á': "a", 'â': "a", 'ã': "a", 'ä': "a", 'å': "a", 'æ': "ae", 'ç': "c", 'è': "e",
+	'é': "e", 'ê': "e", 'ë': "e", 'ì': "i", 'í': "i", 'î': "i", 'ï': "i", 'ð': "d",
+	'ñ': "n", 'ò': "o", 'ó': "o", 'ô': "o", 'õ': "o", 'ö': "o", 'ø': "o", 'ù': "u",
+	'ú': "u", 'û': "u", 'ü': "u", 'ý': "y", 'þ': "th", 'ÿ': "y", 'Ā': "A", 'ā': "a",
+	'Ă': "A", 'ă': "a", 'Ą': "A", 'ą': "a", 'Ć': "C", 'ć': "c", 'Ĉ': "C", 'ĉ': "c",
+	'Ċ': "C", 'ċ': "c", 'Č': "C", 'č': "c", 'Ď': "D", 'ď': "d", 'Đ': "D", 'đ': "d",
+	'Ē': "E", 'ē': "e", 'Ĕ': "E", 'ĕ': "e", 'Ė': "E", 'ė': "e", 'Ę': "E", 'ę': "e",
+	'Ě': "E", 'ě': "e", 'Ĝ': "G", 'ĝ': "g", 'Ğ': "G", 'ğ': "g", 'Ġ': "G", 'ġ': "g",
+	'Ģ': "G", 'ģ': "g", 'Ĥ': "H", 'ĥ': "h", 'Ħ': "H", 'ħ': "h", 'Ĩ': "I", 'ĩ': "i",
+	'Ī': "I", 'ī': "i", 'Ĭ': "I", 'ĭ': "i", 'Į': "I", 'į': "i", 'İ': "I", 'ı': "i",
+	'Ĳ': "IJ", 'ĳ': "ij", 'Ĵ': "J", 'ĵ': "j", 'Ķ': "K", 'ķ': "k", 'ĸ': "k", 'Ĺ': "L",
+	'ĺ': "l", 'Ļ': "L", 'ļ': "l", 'Ľ': "L", 'ľ': "l", 'Ŀ': "L", 'ŀ': "l", 'Ł': "L",
+	'ł': "l", 'Ń': "N", 'ń': "n", 'Ņ': "N", 'ņ': "n", 'Ň': "N", 'ň': "n", 'ŉ': "n",
+	'Ŋ': "N", 'ŋ': "n", 'Ō': "O", 'ō': "o", 'Ŏ': "O", 'ŏ': "o", 'Ő': "O", 'ő': "o",
+	'Œ': "OE", 'œ': "oe", 'Ŕ': "R", 'ŕ': "r", 'Ŗ': "R", 'ŗ': "r", 'Ř': "R", 'ř': "r",
+	'Ś': "S", 'ś': "s", 'Ŝ': "S", 'ŝ': "s", 'Ş': "S", 'ş': "s", 'Š': "S", 'š': "s",
+	'Ţ': "T", 'ţ': "t", 'Ť': "T", 'ť': "t", 'Ŧ': "T", 'ŧ': "t", 'Ũ': "U", 'ũ': "u",
+	'Ū': "U", 'ū': "u", 'Ŭ': "U", 'ŭ': "u", 'Ů': "U", 'ů': "u", 'Ű': "U", 'ű': "u",
+	'Ų': "U", 'ų': "u", 'Ŵ': "W", 'ŵ': "w", 'Ŷ': "Y", 'ŷ': "y", 'Ÿ': "Y", 'Ź': "Z",
+	'ź': "z", 'Ż': "Z", 'ż': "z", 'Ž': "Z", 'ž': "z", 'ſ': "s", 'ƒ': "f", 'Ș': "S",
+	'ș': "s", 'Ț': "T", 'ț': "t",
+	// Cyrillic
+	'а': "a", 'А': "A", 'б': "b", 'Б': "B", 'в': "v", 'В': "V", 'г': "g", 'Г': "G",
+	'д': "d", 'Д': "D", 'е': "e", 'Е': "E", 'ё': "yo", 'Ё': "Yo", 'ж': "zh", 'Ж': "Zh",
+	'з': "z", 'З': "Z", 'и': "i", 'И': "I", 'й': "y", 'Й': "Y", 'к': "k", 'К': "K",
+	'л': "l", 'Л': "L", 'м': "m", 'М': "M", 'н': "n", 'Н': "N", 'о': "o", 'О': "O",
+	'п': "p", 'П': "P", 'р': "r", 'Р': "R", 'с': "s", 'С': "S", 'т': "t", 'Т': "T",
+	'у': "u", 'У': "U", 'ф': "f", 'Ф': "F", 'х': "kh", 'Х': "Kh", 'ц': "ts", 'Ц': "Ts",
+	'ч': "ch", 'Ч': "Ch", 'ш': "sh", 'Ш': "Sh", 'щ': "shch", 'Щ': "Shch", 'ъ': "", 'Ъ': "",
+	'ы': "y", 'Ы': "Y", 'ь': "", 'Ь': "", 'э': "e", 'Э': "E", 'ю': "yu", 'Ю': "Yu",
+	'я': "ya", 'Я': "Ya", 'і': "i", 'І': "I", 'ї': "yi", 'Ї': "Yi", 'є': "ye", 'Є': "Ye",
+	'ґ': "g", 'Ґ': "G", 'ў': "u", 'Ў': "U",
+	// Greek
+	'α': "a", 'Α': "A", 'β': "v", 'Β': "V", 'γ': "g", 'Γ': "G", 'δ': "d", 'Δ': "D",
+	'ε': "e", 'Ε': "E", 'ζ': "z", 'Ζ': "Z", 'η': "i", 'Η': "I", 'θ': "th", 'Θ': "Th",
+	'ι': "i", 'Ι': "I", 'κ': "k", 'Κ': "K", 'λ': "l", 'Λ': "L", 'μ': "m", 'Μ': "M",
+	'ν': "n", 'Ν': "N", 'ξ': "x", 'Ξ': "X", 'ο': "o", 'Ο': "O", 'π': "p", 'Π': "P",
+	'ρ': "r", 'Ρ': "R", 'σ': "s", 'Σ': "S", 'ς': "s", 'τ': "t", 'Τ': "T", 'υ': "y",
+	'Υ': "Y", 'φ': "f", 'Φ': "F", 'χ': "ch", 'Χ': "Ch", 'ψ': "ps", 'Ψ': "Ps", 'ω': "o",
+	'Ω': "O", 'ά': "a", 'Ά': "A", 'έ': "e", 'Έ': "E", 'ή': "i", 'Ή': "I", 'ί': "i",
+	'Ί': "I", 'ό': "o", 'Ό': "O", 'ύ': "y", 'Ύ': "Y", 'ώ': "o", 'Ώ': "O", 'ϊ': "i",
+	'Ϊ': "I", 'ϋ': "y", 'Ϋ': "Y", 'ΐ': "i", 'ΰ': "y",
+}
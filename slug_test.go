@@ -0,0 +1,90 @@
+package fnkit
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSlugify(t *testing.T) {
+	cases := []struct {
+		in   string
+		opts SlugOptions
+		want string
+	}{
+		{"Hello, World!", SlugOptions{}, "hello-world"},
+		{"Crème Brûlée -- recipe", SlugOptions{}, "creme-brulee-recipe"},
+		{"Straße in Łódź", SlugOptions{}, "strasse-in-lodz"},
+		{"Привет, мир", SlugOptions{}, "privet-mir"},
+		{"Καλημέρα κόσμε", SlugOptions{}, "kalimera-kosme"},
+		{"Don't panic", SlugOptions{}, "dont-panic"},
+		{"日本語 title", SlugOptions{}, "title"},
+		{"Go Is Awesome", SlugOptions{Separator: "_", KeepCase: true}, "Go_Is_Awesome"},
+		{"the quick brown fox", SlugOptions{MaxLength: 15}, "the-quick-brown"},
+		{"the quick brown fox", SlugOptions{MaxLength: 14}, "the-quick"},
+		{"supercalifragilistic", SlugOptions{MaxLength: 5}, "super"},
+		{"", SlugOptions{MaxLength: 5}, ""},
+	}
+	for _, c := range cases {
+		if got := Slugify(c.in, c.opts); got != c.want {
+			t.Errorf("Slugify(%q, %+v) = %q, want %q", c.in, c.opts, got, c.want)
+		}
+	}
+}
+
+func TestUniqueSlug(t *testing.T) {
+	taken := map[string]bool{"post": true, "post-2": true, "my_post": true, "long-tit-2": true}
+	exists := func(s string) bool { return taken[s] }
+	if got := UniqueSlug("post", SlugOptions{}, exists); got != "post-3" {
+		t.Errorf("UniqueSlug() = %q", got)
+	}
+	if got := UniqueSlug("fresh", SlugOptions{}, exists); got != "fresh" {
+		t.Errorf("UniqueSlug() unused = %q", got)
+	}
+	if got := UniqueSlug("my_post", SlugOptions{Separator: "_"}, exists); got != "my_post_2" {
+		t.Errorf("UniqueSlug() separator = %q", got)
+	}
+	taken["long-title"] = true
+	if got := UniqueSlug("long-title", SlugOptions{MaxLength: 10}, exists); got != "long-tit-3" {
+		t.Errorf("UniqueSlug() max length = %q", got)
+	}
+}
+
+func TestTransliterate(t *testing.T) {
+	cases := map[string]string{
+		"Ærøskøbing Ѐ":   "AEroskobing Ѐ",
+		"Jalapeño":       "Jalapeno",
+		"Jalapen\u0303o": "Jalapeno", // decomposed
+		"\u0438\u0306":   "i",        // Cyrillic и + combining breve folds to ASCII
+		"\u03b1\u0301":   "a",        // Greek α + combining acute
+		"\u025b\u0301":   "\u025b",   // Latin ɛ + combining acute, no ASCII spelling
+		"नमस्ते":         "नमस्ते",   // Devanagari vowel signs and virama kept
+		"שָׁלוֹם":        "שָׁלוֹם",
+		"مَرْحَبًا":      "مَرْحَبًا",
+	}
+	for in, want := range cases {
+		if got := Transliterate(in); got != want {
+			t.Errorf("Transliterate(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestSafeFilename(t *testing.T) {
+	cases := map[string]string{
+		"report: Q1/Q2?.pdf": "report_ Q1_Q2_.pdf",
+		"  ..hidden.  ":      "hidden",
+		"CON":                "_CON",
+		"lpt1.txt":           "_lpt1.txt",
+		"console.log":        "console.log",
+		"..":                 "_",
+		"a\x00b":             "a_b",
+	}
+	for in, want := range cases {
+		if got := SafeFilename(in); got != want {
+			t.Errorf("SafeFilename(%q) = %q, want %q", in, got, want)
+		}
+	}
+	long := SafeFilename(strings.Repeat("é", 200) + ".txt")
+	if len(long) > 255 || !strings.HasSuffix(long, ".txt") {
+		t.Errorf("SafeFilename() long = %d bytes, %q", len(long), long[len(long)-8:])
+	}
+}
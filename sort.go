@@ -0,0 +1,193 @@
+package fnkit
+
+import (
+	"cmp"
+	"container/heap"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// SortBy sorts the slice 's' in place by the key returned from 'keyFn', in ascending order.
+// The sort is stable: elements with equal keys keep their original order.
+func SortBy[K any, T cmp.Ordered](s []K, keyFn func(K) T) {
+	slices.SortStableFunc(s, By(keyFn))
+}
+
+// SortByDesc sorts the slice 's' in place by the key returned from 'keyFn', in descending order.
+// The sort is stable.
+func SortByDesc[K any, T cmp.Ordered](s []K, keyFn func(K) T) {
+	slices.SortStableFunc(s, By(keyFn).Desc())
+}
+
+// SortStableFunc sorts the slice 's' in place using the comparison function 'cmp', which
+// returns a negative number when a sorts before b, a positive number when it sorts after,
+// and zero when they are equal. Equal elements keep their original order.
+// A Comparator built with By can be passed directly.
+func SortStableFunc[K any](s []K, cmp func(a, b K) int) {
+	slices.SortStableFunc(s, cmp)
+}
+
+// Comparator compares two values, returning a negative number, zero or a positive number
+// when a sorts before, together with or after b. Build one with By and chain with ThenBy:
+//
+//	fnkit.SortStableFunc(people, fnkit.By(func(p Person) string { return p.City }).
+//		ThenBy(fnkit.By(func(p Person) int { return p.Age }).Desc()))
+type Comparator[K any] func(a, b K) int
+
+// By returns a Comparator that orders values by the key returned from 'keyFn'.
+func By[K any, T cmp.Ordered](keyFn func(K) T) Comparator[K] {
+	return func(a, b K) int {
+		return cmp.Compare(keyFn(a), keyFn(b))
+	}
+}
+
+// ThenBy returns a Comparator that orders by c, and by 'next' where c finds values equal.
+func (c Comparator[K]) ThenBy(next Comparator[K]) Comparator[K] {
+	return func(a, b K) int {
+		if r := c(a, b); r != 0 {
+			return r
+		}
+		return next(a, b)
+	}
+}
+
+// Desc returns a Comparator with the order of c reversed.
+func (c Comparator[K]) Desc() Comparator[K] {
+	return func(a, b K) int {
+		return c(b, a)
+	}
+}
+
+// IsSortedBy reports whether the slice 's' is sorted in ascending order by the key
+// returned from 'keyFn'.
+func IsSortedBy[K any, T cmp.Ordered](s []K, keyFn func(K) T) bool {
+	return slices.IsSortedFunc(s, By(keyFn))
+}
+
+// BinarySearchBy searches the slice 's', which must be sorted in ascending order by
+// 'keyFn', for an element whose key equals 'target'. It returns the position where target
+// is found, or where it would be inserted, and whether it was found.
+func BinarySearchBy[K any, T cmp.Ordered](s []K, target T, keyFn func(K) T) (int, bool) {
+	return slices.BinarySearchFunc(s, target, func(v K, t T) int {
+		return cmp.Compare(keyFn(v), t)
+	})
+}
+
+// TopK returns the first 'k' elements of the slice 's' in the order defined by 'less',
+// sorted, without sorting the whole slice. For the three highest scores pass
+// func(a, b int) bool { return a > b }. It uses a bounded heap, so it runs in
+// O(n log k) time and O(k) extra space. The input slice is not modified.
+func TopK[K any](s []K, k int, less func(a, b K) bool) []K {
+	if k <= 0 {
+		return []K{}
+	}
+	// h keeps the k best elements seen so far with the worst of them at the root.
+	h := &boundedHeap[K]{worse: func(a, b K) bool { return less(b, a) }}
+	for _, v := range s {
+		if h.Len() < k {
+			heap.Push(h, v)
+		} else if less(v, h.items[0]) {
+			h.items[0] = v
+			heap.Fix(h, 0)
+		}
+	}
+	result := h.items
+	slices.SortStableFunc(result, func(a, b K) int {
+		switch {
+		case less(a, b):
+			return -1
+		case less(b, a):
+			return 1
+		}
+		return 0
+	})
+	return result
+}
+
+type boundedHeap[K any] struct {
+	items []K
+	worse func(a, b K) bool
+}
+
+func (h *boundedHeap[K]) Len() int           { return len(h.items) }
+func (h *boundedHeap[K]) Less(i, j int) bool { return h.worse(h.items[i], h.items[j]) }
+func (h *boundedHeap[K]) Swap(i, j int)      { h.items[i], h.items[j] = h.items[j], h.items[i] }
+func (h *boundedHeap[K]) Push(x any)         { h.items = append(h.items, x.(K)) }
+func (h *boundedHeap[K]) Pop() any {
+	last := h.items[len(h.items)-1]
+	h.items = h.items[:len(h.items)-1]
+	return last
+}
+
+// NaturalCompare compares a and b in natural order, treating runs of digits as numbers,
+// so "file9" sorts before "file10". It returns -1, 0 or 1. Numbers that are equal but
+// written with different leading zeros are ordered by the number of zeros.
+func NaturalCompare(a, b string) int {
+	for a != "" && b != "" {
+		da, db := isDigitByte(a[0]), isDigitByte(b[0])
+		if da && db {
+			na, nb := digitPrefix(a), digitPrefix(b)
+			if r := compareNumeric(a[:na], b[:nb]); r != 0 {
+				return r
+			}
+			a, b = a[na:], b[nb:]
+			continue
+		}
+		ra, sa := utf8.DecodeRuneInString(a)
+		rb, sb := utf8.DecodeRuneInString(b)
+		if ra != rb {
+			return cmp.Compare(ra, rb)
+		}
+		a, b = a[sa:], b[sb:]
+	}
+	return cmp.Compare(len(a), len(b))
+}
+
+// NaturalLess reports whether a sorts before b in natural order; see NaturalCompare.
+func NaturalLess(a, b string) bool {
+	return NaturalCompare(a, b) < 0
+}
+
+// CompareFold compares a and b ignoring letter case (using Unicode simple case folding),
+// so "apple", "Banana" and "cherry" sort alphabetically regardless of capitalization.
+// Strings that differ only in case are ordered by their bytes, so the result is a total
+// order suitable for sorting.
+func CompareFold(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	for i := 0; i < len(ra) && i < len(rb); i++ {
+		fa, fb := unicode.ToLower(unicode.ToUpper(ra[i])), unicode.ToLower(unicode.ToUpper(rb[i]))
+		if fa != fb {
+			return cmp.Compare(fa, fb)
+		}
+	}
+	if len(ra) != len(rb) {
+		return cmp.Compare(len(ra), len(rb))
+	}
+	return strings.Compare(a, b)
+}
+
+func isDigitByte(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+func digitPrefix(s string) int {
+	n := 0
+	for n < len(s) && isDigitByte(s[n]) {
+		n++
+	}
+	return n
+}
+
+// compareNumeric compares two runs of ASCII digits by numeric value, without overflow.
+func compareNumeric(a, b string) int {
+	ta, tb := strings.TrimLeft(a, "0"), strings.TrimLeft(b, "0")
+	if len(ta) != len(tb) {
+		return cmp.Compare(len(ta), len(tb))
+	}
+	if r := strings.Compare(ta, tb); r != 0 {
+		return r
+	}
+	return cmp.Compare(len(a), len(b))
+}
@@ -0,0 +1,134 @@
+package fnkit
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+type sortPerson struct {
+	Name string
+	City string
+	Age  int
+}
+
+func TestSortBy(t *testing.T) {
+	people := []sortPerson{
+		{"Ada", "London", 36},
+		{"Bob", "Paris", 25},
+		{"Cy", "London", 25},
+		{"Di", "Berlin", 41},
+	}
+	SortBy(people, func(p sortPerson) int { return p.Age })
+	if got := Map(people, func(p sortPerson) string { return p.Name }); !reflect.DeepEqual(got, []string{"Bob", "Cy", "Ada", "Di"}) {
+		t.Errorf("SortBy = %v", got)
+	}
+	SortByDesc(people, func(p sortPerson) int { return p.Age })
+	if got := Map(people, func(p sortPerson) string { return p.Name }); !reflect.DeepEqual(got, []string{"Di", "Ada", "Bob", "Cy"}) {
+		t.Errorf("SortByDesc = %v (ties must keep their order)", got)
+	}
+}
+
+func TestComparator(t *testing.T) {
+	people := []sortPerson{
+		{"Ada", "London", 36},
+		{"Bob", "Paris", 25},
+		{"Cy", "London", 25},
+		{"Di", "Berlin", 41},
+		{"Ed", "London", 36},
+	}
+	byCityThenAgeDesc := By(func(p sortPerson) string { return p.City }).
+		ThenBy(By(func(p sortPerson) int { return p.Age }).Desc())
+	SortStableFunc(people, byCityThenAgeDesc)
+	want := []string{"Di", "Ada", "Ed", "Cy", "Bob"}
+	if got := Map(people, func(p sortPerson) string { return p.Name }); !reflect.DeepEqual(got, want) {
+		t.Errorf("By.ThenBy.Desc = %v, want %v", got, want)
+	}
+	SortStableFunc(people, byCityThenAgeDesc.Desc())
+	want = []string{"Bob", "Cy", "Ada", "Ed", "Di"}
+	if got := Map(people, func(p sortPerson) string { return p.Name }); !reflect.DeepEqual(got, want) {
+		t.Errorf("reversed comparator = %v, want %v", got, want)
+	}
+}
+
+func TestIsSortedByAndBinarySearchBy(t *testing.T) {
+	people := []sortPerson{{"Bob", "", 25}, {"Ada", "", 36}, {"Di", "", 41}}
+	age := func(p sortPerson) int { return p.Age }
+	if !IsSortedBy(people, age) {
+		t.Error("IsSortedBy = false, want true")
+	}
+	if IsSortedBy(people, func(p sortPerson) string { return p.Name }) {
+		t.Error("IsSortedBy by name = true, want false")
+	}
+	if i, ok := BinarySearchBy(people, 36, age); i != 1 || !ok {
+		t.Errorf("BinarySearchBy(36) = %d, %v", i, ok)
+	}
+	if i, ok := BinarySearchBy(people, 30, age); i != 1 || ok {
+		t.Errorf("BinarySearchBy(30) = %d, %v", i, ok)
+	}
+	if i, ok := BinarySearchBy([]sortPerson{}, 30, age); i != 0 || ok {
+		t.Errorf("BinarySearchBy on empty = %d, %v", i, ok)
+	}
+}
+
+func TestTopK(t *testing.T) {
+	s := []int{5, 1, 9, 3, 7, 9, 2}
+	greater := func(a, b int) bool { return a > b }
+	if got := TopK(s, 3, greater); !reflect.DeepEqual(got, []int{9, 9, 7}) {
+		t.Errorf("TopK(3, >) = %v", got)
+	}
+	if got := TopK(s, 2, func(a, b int) bool { return a < b }); !reflect.DeepEqual(got, []int{1, 2}) {
+		t.Errorf("TopK(2, <) = %v", got)
+	}
+	if got := TopK(s, 10, greater); !reflect.DeepEqual(got, []int{9, 9, 7, 5, 3, 2, 1}) {
+		t.Errorf("TopK(10) = %v", got)
+	}
+	if got := TopK(s, 0, greater); len(got) != 0 {
+		t.Errorf("TopK(0) = %v", got)
+	}
+	if !reflect.DeepEqual(s, []int{5, 1, 9, 3, 7, 9, 2}) {
+		t.Errorf("TopK modified its input: %v", s)
+	}
+}
+
+func TestNaturalCompare(t *testing.T) {
+	files := []string{"file10.txt", "file9.txt", "file1.txt", "File2.txt", "file010.txt", "file", "file1a.txt"}
+	slices.SortFunc(files, NaturalCompare)
+	want := []string{"File2.txt", "file", "file1.txt", "file1a.txt", "file9.txt", "file10.txt", "file010.txt"}
+	if !reflect.DeepEqual(files, want) {
+		t.Errorf("NaturalCompare sort = %v, want %v", files, want)
+	}
+	cases := []struct {
+		a, b string
+		want int
+	}{
+		{"a2", "a10", -1},
+		{"a10", "a2", 1},
+		{"v1.10.0", "v1.9.3", 1},
+		{"x", "x", 0},
+		{"99999999999999999999999", "100000000000000000000000", -1},
+	}
+	for _, c := range cases {
+		if got := NaturalCompare(c.a, c.b); got != c.want {
+			t.Errorf("NaturalCompare(%q, %q) = %d, want %d", c.a, c.b, got, c.want)
+		}
+	}
+	if !NaturalLess("img2", "img12") {
+		t.Error("NaturalLess(img2, img12) = false")
+	}
+}
+
+func TestCompareFold(t *testing.T) {
+	words := []string{"cherry", "Banana", "apple", "Apple", "banana"}
+	slices.SortFunc(words, CompareFold)
+	want := []string{"Apple", "apple", "Banana", "banana", "cherry"}
+	if !reflect.DeepEqual(words, want) {
+		t.Errorf("CompareFold sort = %v, want %v", words, want)
+	}
+	if CompareFold("ÉCOLE", "école") >= 0 || CompareFold("Éa", "éb") >= 0 {
+		t.Error("CompareFold should fold non-ASCII letters")
+	}
+	if CompareFold("abc", "ABCD") >= 0 {
+		t.Error("CompareFold(abc, ABCD) should be negative")
+	}
+}
@@ -0,0 +1,241 @@
+package fnkit
+
+import (
+	"cmp"
+	"math"
+	"slices"
+)
+
+// Number is satisfied by every integer and floating-point type, including named types
+// based on them.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// isFloat reports whether N is a floating-point type.
+func isFloat[N Number]() bool {
+	var half N = 1
+	half /= 2
+	return half != 0
+}
+
+// Sum returns the sum of the elements of the slice 's', or 0 for an empty slice.
+// Floating-point values are added with Kahan summation, so long slices of small values
+// do not lose precision the way a naive loop does. Once the running sum is no longer
+// finite, the rest is added plainly, so infinities and overflow give ±Inf, not NaN.
+func Sum[N Number](s []N) N {
+	if !isFloat[N]() {
+		var sum N
+		for _, v := range s {
+			sum += v
+		}
+		return sum
+	}
+	var sum, c N
+	for i, v := range s {
+		y := v - c
+		t := sum + y
+		if f := float64(t); math.IsInf(f, 0) || math.IsNaN(f) {
+			for _, v := range s[i+1:] {
+				t += v
+			}
+			return t
+		}
+		c = (t - sum) - y
+		sum = t
+	}
+	return sum
+}
+
+// SumBy returns the sum of the values returned by 'f' for each element of the slice 's'.
+func SumBy[K any, N Number](s []K, f func(K) N) N {
+	return Sum(Map(s, f))
+}
+
+// Product returns the product of the elements of the slice 's', or 1 for an empty slice.
+func Product[N Number](s []N) N {
+	var product N = 1
+	for _, v := range s {
+		product *= v
+	}
+	return product
+}
+
+// Min returns the smallest element of the slice 's', or None if it is empty.
+// For floats, NaN is propagated as in the builtin min.
+func Min[K cmp.Ordered](s []K) Option[K] {
+	if len(s) == 0 {
+		return None[K]()
+	}
+	return Some(slices.Min(s))
+}
+
+// Max returns the largest element of the slice 's', or None if it is empty.
+func Max[K cmp.Ordered](s []K) Option[K] {
+	if len(s) == 0 {
+		return None[K]()
+	}
+	return Some(slices.Max(s))
+}
+
+// MinBy returns the element of the slice 's' with the smallest key, or None if it is empty.
+// The first such element is returned on ties.
+func MinBy[K any, T cmp.Ordered](s []K, keyFn func(K) T) Option[K] {
+	if len(s) == 0 {
+		return None[K]()
+	}
+	return Some(slices.MinFunc(s, By(keyFn)))
+}
+
+// MaxBy returns the element of the slice 's' with the largest key, or None if it is empty.
+// The first such element is returned on ties.
+func MaxBy[K any, T cmp.Ordered](s []K, keyFn func(K) T) Option[K] {
+	if len(s) == 0 {
+		return None[K]()
+	}
+	return Some(slices.MaxFunc(s, By(keyFn)))
+}
+
+// Mean returns the arithmetic mean of the slice 's', or None if it is empty.
+func Mean[N Number](s []N) Option[float64] {
+	if len(s) == 0 {
+		return None[float64]()
+	}
+	return Some(Sum(toFloats(s)) / float64(len(s)))
+}
+
+// Median returns the middle value of the slice 's', or the mean of the two middle values
+// when its length is even, or None if it is empty. The slice is not modified.
+func Median[N Number](s []N) Option[float64] {
+	return Percentile(s, 50)
+}
+
+// Percentile returns the p-th percentile (0 ≤ p ≤ 100) of the slice 's', interpolating
+// linearly between the closest ranks, as spreadsheets and NumPy do by default. It returns
+// None if the slice is empty or p is out of range. The slice is not modified.
+func Percentile[N Number](s []N, p float64) Option[float64] {
+	if len(s) == 0 || !(p >= 0 && p <= 100) {
+		return None[float64]()
+	}
+	sorted := toFloats(s)
+	slices.Sort(sorted)
+	rank := p / 100 * float64(len(sorted)-1)
+	lo := int(math.Floor(rank))
+	hi := min(lo+1, len(sorted)-1)
+	return Some(sorted[lo] + (sorted[hi]-sorted[lo])*(rank-float64(lo)))
+}
+
+// Mode returns the most frequent element of the slice 's', or None if it is empty.
+// When several elements are equally frequent, the one seen first wins.
+func Mode[K comparable](s []K) Option[K] {
+	if len(s) == 0 {
+		return None[K]()
+	}
+	counts := make(map[K]int, len(s))
+	bestCount := 0
+	for _, v := range s {
+		counts[v]++
+		bestCount = max(bestCount, counts[v])
+	}
+	for _, v := range s {
+		if counts[v] == bestCount {
+			return Some(v)
+		}
+	}
+	panic("unreachable")
+}
+
+// Variance returns the population variance of the slice 's', or None if it is empty.
+// It uses Welford's algorithm, which stays accurate when the values are large and close
+// together. For the sample variance multiply by n/(n-1).
+func Variance[N Number](s []N) Option[float64] {
+	if len(s) == 0 {
+		return None[float64]()
+	}
+	var mean, m2 float64
+	for i, v := range s {
+		x := float64(v)
+		delta := x - mean
+		mean += delta / float64(i+1)
+		m2 += delta * (x - mean)
+	}
+	return Some(m2 / float64(len(s)))
+}
+
+// StdDev returns the population standard deviation of the slice 's', or None if it is empty.
+func StdDev[N Number](s []N) Option[float64] {
+	v := Variance(s)
+	if v.IsNone() {
+		return v
+	}
+	return Some(math.Sqrt(v.Unwrap()))
+}
+
+// Bin is one bucket of a Histogram, counting the values in [Low, High). The last bin also
+// includes its High bound.
+type Bin struct {
+	Low, High float64
+	Count     int
+}
+
+// Histogram sorts the values of the slice 's' into 'bins' equal-width buckets spanning its
+// minimum to its maximum. It returns nil if the slice is empty or bins is not positive.
+// If every value is equal, they all land in the first bin.
+func Histogram[N Number](s []N, bins int) []Bin {
+	if len(s) == 0 || bins <= 0 {
+		return nil
+	}
+	values := toFloats(s)
+	lo, hi := slices.Min(values), slices.Max(values)
+	width := (hi - lo) / float64(bins)
+	result := make([]Bin, bins)
+	for i := range result {
+		result[i] = Bin{Low: lo + float64(i)*width, High: lo + float64(i+1)*width}
+	}
+	result[bins-1].High = hi
+	for _, v := range values {
+		i := 0
+		if width > 0 {
+			i = min(int((v-lo)/width), bins-1)
+		}
+		result[i].Count++
+	}
+	return result
+}
+
+// CountBy counts the elements of the slice 's' for each key returned by 'keyFn'.
+func CountBy[K any, T comparable](s []K, keyFn func(K) T) map[T]int {
+	result := make(map[T]int)
+	for _, v := range s {
+		result[keyFn(v)]++
+	}
+	return result
+}
+
+// GroupByAgg groups the elements of the slice 's' by the key returned from 'keyFn' and
+// reduces each group with 'f', starting from 'initial', without building the groups:
+//
+//	totals := fnkit.GroupByAgg(orders, func(o Order) string { return o.Customer }, 0.0,
+//		func(sum float64, o Order) float64 { return sum + o.Total })
+func GroupByAgg[K any, T comparable, A any](s []K, keyFn func(K) T, initial A, f func(A, K) A) map[T]A {
+	result := make(map[T]A)
+	for _, v := range s {
+		key := keyFn(v)
+		acc, ok := result[key]
+		if !ok {
+			acc = initial
+		}
+		result[key] = f(acc, v)
+	}
+	return result
+}
+
+func toFloats[N Number](s []N) []float64 {
+	result := make([]float64, len(s))
+	for i, v := range s {
+		result[i] = float64(v)
+	}
+	return result
+}
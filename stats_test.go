@@ -0,0 +1,172 @@
+package fnkit
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestSumProduct(t *testing.T) {
+	if got := Sum([]int{1, 2, 3, 4}); got != 10 {
+		t.Errorf("Sum = %d", got)
+	}
+	if got := Sum([]uint8{}); got != 0 {
+		t.Errorf("Sum of empty = %d", got)
+	}
+	if got := Product([]int{2, 3, 4}); got != 24 {
+		t.Errorf("Product = %d", got)
+	}
+	if got := Product([]float64{}); got != 1 {
+		t.Errorf("Product of empty = %v", got)
+	}
+
+	// 0.1 added a hundred thousand times drifts by about 2e-8 with a naive loop.
+	tenths := make([]float64, 100_000)
+	for i := range tenths {
+		tenths[i] = 0.1
+	}
+	if got := Sum(tenths); math.Abs(got-10_000) > 1e-10 {
+		t.Errorf("Kahan Sum = %.12f, want 10000", got)
+	}
+
+	inf := math.Inf(1)
+	if got := Sum([]float64{inf, 1}); !math.IsInf(got, 1) {
+		t.Errorf("Sum(+Inf, 1) = %v, want +Inf", got)
+	}
+	if got := Sum([]float64{1, -inf}); !math.IsInf(got, -1) {
+		t.Errorf("Sum(1, -Inf) = %v, want -Inf", got)
+	}
+	if got := Sum([]float64{1e308, 1e308, -1e308}); !math.IsInf(got, 1) {
+		t.Errorf("Sum overflow = %v, want +Inf", got)
+	}
+	if got := Sum([]float32{3e38, 3e38}); !math.IsInf(float64(got), 1) {
+		t.Errorf("Sum float32 overflow = %v, want +Inf", got)
+	}
+	if got := Sum([]float64{inf, -inf}); !math.IsNaN(got) {
+		t.Errorf("Sum(+Inf, -Inf) = %v, want NaN", got)
+	}
+	if got := Mean([]float64{inf, 2}); !math.IsInf(got.Unwrap(), 1) {
+		t.Errorf("Mean(+Inf, 2) = %v, want +Inf", got)
+	}
+
+	type cents int64
+	if got := SumBy([]string{"a", "bb", "ccc"}, func(s string) cents { return cents(len(s)) }); got != 6 {
+		t.Errorf("SumBy = %d", got)
+	}
+}
+
+func TestMinMax(t *testing.T) {
+	if got := Min([]int{3, 1, 2}); got.Unwrap() != 1 {
+		t.Errorf("Min = %v", got.Unwrap())
+	}
+	if got := Max([]string{"b", "c", "a"}); got.Unwrap() != "c" {
+		t.Errorf("Max = %v", got.Unwrap())
+	}
+	if Min([]int{}).IsSome() || Max([]int(nil)).IsSome() {
+		t.Error("Min/Max of empty should be None")
+	}
+	type item struct {
+		name  string
+		price int
+	}
+	items := []item{{"a", 3}, {"b", 1}, {"c", 5}, {"d", 1}, {"e", 5}}
+	price := func(i item) int { return i.price }
+	if got := MinBy(items, price).Unwrap(); got.name != "b" {
+		t.Errorf("MinBy = %v", got)
+	}
+	if got := MaxBy(items, price).Unwrap(); got.name != "c" {
+		t.Errorf("MaxBy = %v", got)
+	}
+	if MinBy([]item{}, price).IsSome() {
+		t.Error("MinBy of empty should be None")
+	}
+}
+
+func TestMeanMedianPercentile(t *testing.T) {
+	s := []int{4, 1, 3, 2}
+	if got := Mean(s).Unwrap(); got != 2.5 {
+		t.Errorf("Mean = %v", got)
+	}
+	if got := Median(s).Unwrap(); got != 2.5 {
+		t.Errorf("Median (even) = %v", got)
+	}
+	if got := Median([]int{5, 1, 3}).Unwrap(); got != 3 {
+		t.Errorf("Median (odd) = %v", got)
+	}
+	if !reflect.DeepEqual(s, []int{4, 1, 3, 2}) {
+		t.Errorf("Median modified its input: %v", s)
+	}
+	cases := []struct {
+		p    float64
+		want float64
+	}{{0, 1}, {100, 4}, {25, 1.75}, {90, 3.7}}
+	for _, c := range cases {
+		if got := Percentile(s, c.p).Unwrap(); math.Abs(got-c.want) > 1e-9 {
+			t.Errorf("Percentile(%v) = %v, want %v", c.p, got, c.want)
+		}
+	}
+	if Percentile(s, 101).IsSome() || Percentile(s, math.NaN()).IsSome() || Percentile([]int{}, 50).IsSome() {
+		t.Error("Percentile should be None for empty input or p out of range")
+	}
+	if Mean([]float64{}).IsSome() || Median([]float64{}).IsSome() {
+		t.Error("Mean/Median of empty should be None")
+	}
+}
+
+func TestModeVarianceStdDev(t *testing.T) {
+	if got := Mode([]string{"b", "a", "a", "b", "c"}).Unwrap(); got != "b" {
+		t.Errorf("Mode tie = %q, want first seen %q", got, "b")
+	}
+	if got := Mode([]int{1, 2, 2, 3}).Unwrap(); got != 2 {
+		t.Errorf("Mode = %d", got)
+	}
+	if Mode([]int{}).IsSome() {
+		t.Error("Mode of empty should be None")
+	}
+	s := []float64{2, 4, 4, 4, 5, 5, 7, 9}
+	if got := Variance(s).Unwrap(); got != 4 {
+		t.Errorf("Variance = %v", got)
+	}
+	if got := StdDev(s).Unwrap(); got != 2 {
+		t.Errorf("StdDev = %v", got)
+	}
+	// Large values close together lose all precision with the textbook formula.
+	big := []float64{1e9 + 4, 1e9 + 7, 1e9 + 13, 1e9 + 16}
+	if got := Variance(big).Unwrap(); math.Abs(got-22.5) > 1e-6 {
+		t.Errorf("Variance of large values = %v, want 22.5", got)
+	}
+	if StdDev([]int{}).IsSome() {
+		t.Error("StdDev of empty should be None")
+	}
+}
+
+func TestHistogram(t *testing.T) {
+	got := Histogram([]float64{0, 1, 2, 3, 4, 5, 6, 7, 8, 10}, 5)
+	want := []Bin{{0, 2, 2}, {2, 4, 2}, {4, 6, 2}, {6, 8, 2}, {8, 10, 2}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Histogram = %v, want %v", got, want)
+	}
+	if got := Histogram([]int{3, 3}, 2); got[0].Count != 2 || got[1].Count != 0 {
+		t.Errorf("Histogram of equal values = %v", got)
+	}
+	if Histogram([]int{}, 3) != nil || Histogram([]int{1}, 0) != nil {
+		t.Error("Histogram should be nil for empty input or no bins")
+	}
+}
+
+func TestCountByGroupByAgg(t *testing.T) {
+	words := []string{"go", "rust", "c", "zig", "java"}
+	if got := CountBy(words, func(s string) int { return len(s) }); !reflect.DeepEqual(got, map[int]int{1: 1, 2: 1, 3: 1, 4: 2}) {
+		t.Errorf("CountBy = %v", got)
+	}
+	type order struct {
+		customer string
+		total    float64
+	}
+	orders := []order{{"ann", 10}, {"bob", 5}, {"ann", 2.5}}
+	totals := GroupByAgg(orders, func(o order) string { return o.customer }, 0.0,
+		func(sum float64, o order) float64 { return sum + o.total })
+	if !reflect.DeepEqual(totals, map[string]float64{"ann": 12.5, "bob": 5}) {
+		t.Errorf("GroupByAgg = %v", totals)
+	}
+}
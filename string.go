@@ -1,6 +1,7 @@
 package fnkit
 
 import (
+	"sort"
 	"strings"
 	"unicode"
 )
@@ -35,13 +36,12 @@ func Count(s, substr string) int {
 	return strings.Count(s, substr)
 }
 
-// Reverse returns the string s reversed (Unicode-safe).
+// ReverseString returns the string s reversed by grapheme cluster, so combining accents,
+// flags and emoji ZWJ sequences stay intact.
 func ReverseString(s string) string {
-	runes := []rune(s)
-	for i, j := 0, len(runes)-1; i < j; i, j = i+1, j-1 {
-		runes[i], runes[j] = runes[j], runes[i]
-	}
-	return string(runes)
+	clusters := Graphemes(s)
+	Reverse(clusters)
+	return strings.Join(clusters, "")
 }
 
 // IsAlpha returns true if all characters in s are letters.
@@ -111,52 +111,231 @@ func Words(s string) []string {
 }
 
 // CamelCase converts a string to camelCase.
+// Word boundaries are detected as described in SnakeCase, so "HTTPServer" becomes "httpServer".
 func CamelCase(s string) string {
-	s = strings.TrimSpace(s)
-	if s == "" {
-		return s
+	return defaultCaser.Camel(s)
+}
+
+// PascalCase converts a string to PascalCase.
+func PascalCase(s string) string {
+	return defaultCaser.Pascal(s)
+}
+
+// SnakeCase converts a string to snake_case.
+// Words are split on non-alphanumeric characters, on lower→upper and digit→upper transitions
+// ("userID" → "user_id", "utf8String" → "utf8_string") and at the end of an acronym
+// ("HTTPServer" → "http_server"). Digits stay in the word they follow, and a version letter
+// stays with its acronym ("IPv4Address" → "ipv4_address"). SnakeCase(CamelCase(x))
+// round-trips for snake_case x whose words start with a letter.
+func SnakeCase(s string) string {
+	return defaultCaser.Snake(s)
+}
+
+// KebabCase converts a string to kebab-case.
+func KebabCase(s string) string {
+	return defaultCaser.Kebab(s)
+}
+
+// ConstantCase converts a string to CONSTANT_CASE.
+func ConstantCase(s string) string {
+	return defaultCaser.Constant(s)
+}
+
+// DotCase converts a string to dot.case.
+func DotCase(s string) string {
+	return defaultCaser.Dot(s)
+}
+
+// TrainCase converts a string to Train-Case.
+func TrainCase(s string) string {
+	return defaultCaser.Train(s)
+}
+
+// TitleCase converts a string to Title Case, joining words with single spaces.
+// Small words such as "a", "of" and "the" stay lowercase unless they are the first or last word.
+func TitleCase(s string) string {
+	return defaultCaser.Title(s)
+}
+
+// Caser performs case conversions with a configurable list of acronyms.
+// Known acronyms are kept as a single word when tokenizing ("OAuthToken" → "oauth_token")
+// and are written in their canonical form in camel, Pascal, train and title case
+// ("user_id" → "userID" when "ID" is registered).
+// The zero value is ready to use and knows no acronyms.
+type Caser struct {
+	acronyms map[string]string
+	byLength [][]rune
+}
+
+var defaultCaser = &Caser{}
+
+// NewCaser returns a Caser that recognizes the given acronyms, e.g. NewCaser("ID", "HTTP", "OAuth").
+func NewCaser(acronyms ...string) *Caser {
+	c := &Caser{acronyms: make(map[string]string, len(acronyms))}
+	for _, a := range acronyms {
+		if a == "" {
+			continue
+		}
+		c.acronyms[strings.ToLower(a)] = a
+		c.byLength = append(c.byLength, []rune(a))
 	}
-	words := strings.FieldsFunc(s, func(r rune) bool {
+	sort.Slice(c.byLength, func(i, j int) bool { return len(c.byLength[i]) > len(c.byLength[j]) })
+	return c
+}
+
+// Words splits s into the words used by the case conversions.
+func (c *Caser) Words(s string) []string {
+	var words []string
+	fields := strings.FieldsFunc(s, func(r rune) bool {
 		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
 	})
-	for i := range words {
+	for _, field := range fields {
+		runes := []rune(field)
+		start := 0
+		for i := 0; i < len(runes); {
+			if i == start {
+				if n := c.matchAcronym(runes[i:]); n > 0 {
+					words = append(words, string(runes[i:i+n]))
+					i += n
+					start = i
+					continue
+				}
+			}
+			if i > start && isWordBoundary(runes, i) {
+				words = append(words, string(runes[start:i]))
+				start = i
+				continue
+			}
+			i++
+		}
+		if start < len(runes) {
+			words = append(words, string(runes[start:]))
+		}
+	}
+	return words
+}
+
+// Camel converts s to camelCase.
+func (c *Caser) Camel(s string) string {
+	words := c.Words(s)
+	for i, w := range words {
 		if i == 0 {
-			words[i] = strings.ToLower(words[i])
+			words[i] = strings.ToLower(w)
 		} else {
-			words[i] = Capitalize(words[i])
+			words[i] = c.capitalize(w)
 		}
 	}
 	return strings.Join(words, "")
 }
 
-// SnakeCase converts a string to snake_case.
-func SnakeCase(s string) string {
-	s = strings.TrimSpace(s)
-	if s == "" {
-		return s
+// Pascal converts s to PascalCase.
+func (c *Caser) Pascal(s string) string {
+	return c.join(s, "", c.capitalize)
+}
+
+// Snake converts s to snake_case.
+func (c *Caser) Snake(s string) string {
+	return c.join(s, "_", strings.ToLower)
+}
+
+// Kebab converts s to kebab-case.
+func (c *Caser) Kebab(s string) string {
+	return c.join(s, "-", strings.ToLower)
+}
+
+// Constant converts s to CONSTANT_CASE.
+func (c *Caser) Constant(s string) string {
+	return c.join(s, "_", strings.ToUpper)
+}
+
+// Dot converts s to dot.case.
+func (c *Caser) Dot(s string) string {
+	return c.join(s, ".", strings.ToLower)
+}
+
+// Train converts s to Train-Case.
+func (c *Caser) Train(s string) string {
+	return c.join(s, "-", c.capitalize)
+}
+
+// Title converts s to Title Case.
+func (c *Caser) Title(s string) string {
+	words := c.Words(s)
+	for i, w := range words {
+		lower := strings.ToLower(w)
+		if _, small := titleSmallWords[lower]; small && i > 0 && i < len(words)-1 {
+			words[i] = lower
+		} else {
+			words[i] = c.capitalize(w)
+		}
 	}
-	words := strings.FieldsFunc(s, func(r rune) bool {
-		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
-	})
-	for i := range words {
-		words[i] = strings.ToLower(words[i])
+	return strings.Join(words, " ")
+}
+
+// titleSmallWords are articles, conjunctions and short prepositions left lowercase by TitleCase.
+var titleSmallWords = map[string]struct{}{
+	"a": {}, "an": {}, "and": {}, "as": {}, "at": {}, "but": {}, "by": {}, "for": {},
+	"if": {}, "in": {}, "nor": {}, "of": {}, "on": {}, "or": {}, "per": {}, "so": {},
+	"the": {}, "to": {}, "up": {}, "via": {}, "vs": {}, "yet": {},
+}
+
+func (c *Caser) join(s, sep string, f func(string) string) string {
+	words := c.Words(s)
+	for i, w := range words {
+		words[i] = f(w)
 	}
-	return strings.Join(words, "_")
+	return strings.Join(words, sep)
 }
 
-// KebabCase converts a string to kebab-case.
-func KebabCase(s string) string {
-	s = strings.TrimSpace(s)
-	if s == "" {
-		return s
+// capitalize returns the canonical form of a known acronym, or w with only its first letter upper-cased.
+func (c *Caser) capitalize(w string) string {
+	if a, ok := c.acronyms[strings.ToLower(w)]; ok {
+		return a
 	}
-	words := strings.FieldsFunc(s, func(r rune) bool {
-		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
-	})
-	for i := range words {
-		words[i] = strings.ToLower(words[i])
+	return Capitalize(w)
+}
+
+// matchAcronym returns the length of the longest acronym that prefixes runes as a whole word, or 0.
+// The acronym must end at a word boundary: the end of runes, a non-alphanumeric, an upper-case letter
+// that starts a new word ("HTTPServer") or another acronym, or a plural "s" that is kept with
+// it ("IDs"). So "ID" does not match in "IDENTITY", nor "HTTP" in "HTTPS".
+func (c *Caser) matchAcronym(runes []rune) int {
+	for _, a := range c.byLength {
+		n := len(a)
+		if n > len(runes) || string(runes[:n]) != string(a) {
+			continue
+		}
+		if n == len(runes) || !unicode.IsLetter(runes[n]) && !unicode.IsDigit(runes[n]) {
+			return n
+		}
+		if isPluralS(runes, n) {
+			return n + 1
+		}
+		if unicode.IsUpper(runes[n]) && (n+1 < len(runes) && unicode.IsLower(runes[n+1]) || c.matchAcronym(runes[n:]) > 0) {
+			return n
+		}
 	}
-	return strings.Join(words, "-")
+	return 0
+}
+
+// isPluralS reports whether runes[i] is a lower-case "s" that ends a word, as in "IDs".
+func isPluralS(runes []rune, i int) bool {
+	return runes[i] == 's' && (i+1 == len(runes) || !unicode.IsLower(runes[i+1]))
+}
+
+// isWordBoundary reports whether a new word starts at runes[i] inside an alphanumeric run.
+func isWordBoundary(runes []rune, i int) bool {
+	prev, cur := runes[i-1], runes[i]
+	if !unicode.IsUpper(cur) {
+		return false
+	}
+	if !unicode.IsUpper(prev) {
+		return true
+	}
+	if i+2 < len(runes) && unicode.IsLower(runes[i+1]) && unicode.IsDigit(runes[i+2]) {
+		return false // a version letter, as in "IPv4"
+	}
+	return i+1 < len(runes) && unicode.IsLower(runes[i+1]) && !isPluralS(runes, i+1)
 }
 
 // IsUpper returns true if all letters in s are uppercase.
@@ -224,13 +403,23 @@ func Keep(s string, keep func(rune) bool) string {
 	return string(out)
 }
 
-// PadCenter pads s on both sides with padChar to center it in a string of length width.
+// PadCenter pads s on both sides with padChar to center it in a string of display width width.
+// Widths are measured with DisplayWidth, so East Asian wide characters count as two columns.
 func PadCenter(s string, width int, padChar rune) string {
-	if len(s) >= width {
+	pad := width - DisplayWidth(s)
+	if pad <= 0 {
 		return s
 	}
-	pad := width - len(s)
 	left := pad / 2
-	right := pad - left
-	return strings.Repeat(string(padChar), left) + s + strings.Repeat(string(padChar), right)
+	return padding(padChar, left) + s + padding(padChar, pad-left)
+}
+
+// PadLeft pads s on the left with padChar to a display width of width.
+func PadLeft(s string, width int, padChar rune) string {
+	return padding(padChar, width-DisplayWidth(s)) + s
+}
+
+// PadRight pads s on the right with padChar to a display width of width.
+func PadRight(s string, width int, padChar rune) string {
+	return s + padding(padChar, width-DisplayWidth(s))
 }
@@ -97,6 +97,93 @@ func TestCaseConversions(t *testing.T) {
 	}
 }
 
+func TestCaseWordBoundaries(t *testing.T) {
+	cases := []struct{ in, snake, camel string }{
+		{"userID", "user_id", "userId"},
+		{"HTTPServer", "http_server", "httpServer"},
+		{"parseHTMLDocument", "parse_html_document", "parseHtmlDocument"},
+		{"version2Beta", "version2_beta", "version2Beta"},
+		{"ipv4_address", "ipv4_address", "ipv4Address"},
+		{"IPv4Address", "ipv4_address", "ipv4Address"},
+		{"utf8String", "utf8_string", "utf8String"},
+		{"HTTP2Server", "http2_server", "http2Server"},
+		{"userIDs", "user_ids", "userIds"},
+		{"listURLsByID", "list_urls_by_id", "listUrlsById"},
+		{"  Already_snake-case ", "already_snake_case", "alreadySnakeCase"},
+		{"", "", ""},
+	}
+	for _, c := range cases {
+		if got := SnakeCase(c.in); got != c.snake {
+			t.Errorf("SnakeCase(%q) = %q, want %q", c.in, got, c.snake)
+		}
+		if got := CamelCase(c.in); got != c.camel {
+			t.Errorf("CamelCase(%q) = %q, want %q", c.in, got, c.camel)
+		}
+		if got := SnakeCase(CamelCase(c.snake)); got != c.snake {
+			t.Errorf("SnakeCase(CamelCase(%q)) = %q", c.snake, got)
+		}
+	}
+}
+
+func TestMoreCaseConversions(t *testing.T) {
+	s := "userAccountID"
+	checks := map[string]string{
+		PascalCase(s):   "UserAccountId",
+		ConstantCase(s): "USER_ACCOUNT_ID",
+		DotCase(s):      "user.account.id",
+		TrainCase(s):    "User-Account-Id",
+		KebabCase(s):    "user-account-id",
+	}
+	for got, want := range checks {
+		if got != want {
+			t.Errorf("got %q, want %q", got, want)
+		}
+	}
+	if got := TitleCase("the lord of the rings"); got != "The Lord of the Rings" {
+		t.Errorf("TitleCase() = %q", got)
+	}
+	if got := TitleCase("what we are made of"); got != "What We Are Made Of" {
+		t.Errorf("TitleCase() last small word = %q", got)
+	}
+}
+
+func TestCaserAcronyms(t *testing.T) {
+	c := NewCaser("ID", "HTTP", "OAuth")
+	if got := c.Camel("user_id"); got != "userID" {
+		t.Errorf("Camel() = %q", got)
+	}
+	if got := c.Pascal("http_server"); got != "HTTPServer" {
+		t.Errorf("Pascal() = %q", got)
+	}
+	if got := c.Snake("OAuthToken"); got != "oauth_token" {
+		t.Errorf("Snake() = %q", got)
+	}
+	if got := c.Title("oauth login for user id"); got != "OAuth Login for User ID" {
+		t.Errorf("Title() = %q", got)
+	}
+	if got := c.Snake(c.Camel("http_request_id")); got != "http_request_id" {
+		t.Errorf("round trip = %q", got)
+	}
+
+	snake := []struct{ in, want string }{
+		{"IDENTITY", "identity"},
+		{"HTTPS", "https"},
+		{"HTTPSProxy", "https_proxy"},
+		{"userIDs", "user_ids"},
+		{"IDsByHTTP", "ids_by_http"},
+		{"HTTPID", "http_id"},
+		{"OAuthID", "oauth_id"},
+		{"ID2", "id2"},
+		{"IDv2", "idv2"},
+		{"Identity", "identity"},
+	}
+	for _, s := range snake {
+		if got := c.Snake(s.in); got != s.want {
+			t.Errorf("Snake(%q) = %q, want %q", s.in, got, s.want)
+		}
+	}
+}
+
 func TestIsUpperLower(t *testing.T) {
 	if !IsUpper("ABC") || IsUpper("AbC") {
 		t.Errorf("IsUpper failed")
@@ -129,3 +216,18 @@ func TestPadCenter(t *testing.T) {
 		t.Errorf("PadCenter() = %q", got)
 	}
 }
+
+func TestPadWidthAware(t *testing.T) {
+	if got := PadLeft("42", 5, ' '); got != "   42" {
+		t.Errorf("PadLeft() = %q", got)
+	}
+	if got := PadRight("名前", 6, '.'); got != "名前.." {
+		t.Errorf("PadRight() wide = %q", got)
+	}
+	if got := PadCenter("日本", 8, '-'); got != "--日本--" {
+		t.Errorf("PadCenter() wide = %q", got)
+	}
+	if got := PadCenter("Zoe\u0308", 5, ' '); got != " Zoe\u0308 " {
+		t.Errorf("PadCenter() combining = %q", got)
+	}
+}
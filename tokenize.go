@@ -0,0 +1,148 @@
+package fnkit
+
+import (
+	"errors"
+	"strings"
+)
+
+// ErrUnterminatedQuote is returned by SplitQuoted when a quote is not closed.
+var ErrUnterminatedQuote = errors.New("unterminated quote")
+
+// ErrTrailingEscape is returned by SplitQuoted when s ends with an unescaped backslash.
+var ErrTrailingEscape = errors.New("trailing backslash")
+
+// SplitQuoted splits a command line into arguments the way a POSIX shell does, without
+// expanding variables or globs:
+//
+//	fnkit.SplitQuoted(`grep -e "hello world" 'it''s' a\ b`)
+//	// []string{"grep", "-e", "hello world", "its", "a b"}
+//
+// Unquoted whitespace separates arguments. Single quotes preserve everything up to the
+// closing quote. Inside double quotes a backslash escapes only $, `, ", \ and newline.
+// Outside quotes a backslash escapes any character, and backslash-newline is removed.
+// Adjacent quoted and unquoted parts join into one argument, and "" yields an empty one.
+func SplitQuoted(s string) ([]string, error) {
+	var args []string
+	var cur strings.Builder
+	inArg := false
+	runes := []rune(s)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case r == ' ' || r == '\t' || r == '\n':
+			if inArg {
+				args = append(args, cur.String())
+				cur.Reset()
+				inArg = false
+			}
+		case r == '\\':
+			i++
+			if i == len(runes) {
+				return nil, ErrTrailingEscape
+			}
+			if runes[i] == '\n' {
+				continue
+			}
+			cur.WriteRune(runes[i])
+			inArg = true
+		case r == '\'':
+			end := indexRune(runes, '\'', i+1)
+			if end < 0 {
+				return nil, ErrUnterminatedQuote
+			}
+			cur.WriteString(string(runes[i+1 : end]))
+			i = end
+			inArg = true
+		case r == '"':
+			i++
+			for ; i < len(runes) && runes[i] != '"'; i++ {
+				if runes[i] == '\\' && i+1 < len(runes) && strings.ContainsRune("$`\"\\\n", runes[i+1]) {
+					i++
+					if runes[i] == '\n' {
+						continue
+					}
+				}
+				cur.WriteRune(runes[i])
+			}
+			if i == len(runes) {
+				return nil, ErrUnterminatedQuote
+			}
+			inArg = true
+		default:
+			cur.WriteRune(r)
+			inArg = true
+		}
+	}
+	if inArg {
+		args = append(args, cur.String())
+	}
+	return args, nil
+}
+
+func indexRune(runes []rune, r rune, from int) int {
+	for i := from; i < len(runes); i++ {
+		if runes[i] == r {
+			return i
+		}
+	}
+	return -1
+}
+
+// QuoteShell joins args into a command line that a POSIX shell, or SplitQuoted, splits back
+// into the same arguments. Arguments made only of safe characters are left bare; others are
+// single-quoted, with each embedded single quote closing the quotes, adding an escaped
+// quote and reopening them:
+//
+//	QuoteShell("it's") // 'it'\''s'
+func QuoteShell(args ...string) string {
+	quoted := make([]string, len(args))
+	for i, a := range args {
+		quoted[i] = quoteShellArg(a)
+	}
+	return strings.Join(quoted, " ")
+}
+
+func quoteShellArg(s string) string {
+	if s == "" {
+		return "''"
+	}
+	safe := true
+	for _, r := range s {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || strings.ContainsRune("@%+=:,./_-", r)) {
+			safe = false
+			break
+		}
+	}
+	if safe {
+		return s
+	}
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// SplitEscaped splits s on sep, except where sep is preceded by escape. The escape rune is
+// removed and the rune after it kept literally, so with sep ',' and escape '\\',
+// `a\,b,c\\` splits into "a,b" and `c\`. A trailing escape is kept as is.
+// Like strings.Split, an empty s yields one empty field.
+func SplitEscaped(s string, sep, escape rune) []string {
+	var fields []string
+	var cur strings.Builder
+	escaped := false
+	for _, r := range s {
+		switch {
+		case escaped:
+			cur.WriteRune(r)
+			escaped = false
+		case r == escape:
+			escaped = true
+		case r == sep:
+			fields = append(fields, cur.String())
+			cur.Reset()
+		default:
+			cur.WriteRune(r)
+		}
+	}
+	if escaped {
+		cur.WriteRune(escape)
+	}
+	return append(fields, cur.String())
+}
@@ -0,0 +1,69 @@
+package fnkit
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestSplitQuoted(t *testing.T) {
+	cases := []struct {
+		in   string
+		want []string
+	}{
+		{`grep -e "hello world" 'it''s' a\ b`, []string{"grep", "-e", "hello world", "its", "a b"}},
+		{`  echo   "" ''  x`, []string{"echo", "", "", "x"}},
+		{`say "a \"quoted\" \$HOME \n"`, []string{"say", `a "quoted" $HOME \n`}},
+		{`'single \ "keeps" everything'`, []string{`single \ "keeps" everything`}},
+		{"line\\\ncontinued", []string{"linecontinued"}},
+		{`pre"mid"'post'`, []string{"premidpost"}},
+		{"", nil},
+	}
+	for _, c := range cases {
+		got, err := SplitQuoted(c.in)
+		if err != nil || !reflect.DeepEqual(got, c.want) {
+			t.Errorf("SplitQuoted(%q) = %q, %v; want %q", c.in, got, err, c.want)
+		}
+	}
+	if _, err := SplitQuoted(`echo "open`); !errors.Is(err, ErrUnterminatedQuote) {
+		t.Errorf("expected ErrUnterminatedQuote, got %v", err)
+	}
+	if _, err := SplitQuoted(`echo 'open`); !errors.Is(err, ErrUnterminatedQuote) {
+		t.Errorf("expected ErrUnterminatedQuote, got %v", err)
+	}
+	if _, err := SplitQuoted(`echo \`); !errors.Is(err, ErrTrailingEscape) {
+		t.Errorf("expected ErrTrailingEscape, got %v", err)
+	}
+}
+
+func TestQuoteShell(t *testing.T) {
+	args := []string{"ls", "-la", "my file", "it's", "", "$HOME", "a/b.txt", "naïve"}
+	line := QuoteShell(args...)
+	if line != `ls -la 'my file' 'it'\''s' '' '$HOME' a/b.txt 'naïve'` {
+		t.Errorf("QuoteShell() = %s", line)
+	}
+	back, err := SplitQuoted(line)
+	if err != nil || !reflect.DeepEqual(back, args) {
+		t.Errorf("round trip = %q, %v", back, err)
+	}
+}
+
+func TestSplitEscaped(t *testing.T) {
+	cases := []struct {
+		in   string
+		want []string
+	}{
+		{`a\,b,c\\`, []string{"a,b", `c\`}},
+		{"a,,b", []string{"a", "", "b"}},
+		{"", []string{""}},
+		{`trailing\`, []string{`trailing\`}},
+	}
+	for _, c := range cases {
+		if got := SplitEscaped(c.in, ',', '\\'); !reflect.DeepEqual(got, c.want) {
+			t.Errorf("SplitEscaped(%q) = %q, want %q", c.in, got, c.want)
+		}
+	}
+	if got := SplitEscaped("k=v;x=y^;z", ';', '^'); !reflect.DeepEqual(got, []string{"k=v", "x=y;z"}) {
+		t.Errorf("SplitEscaped() custom escape = %q", got)
+	}
+}
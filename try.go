@@ -0,0 +1,112 @@
+package fnkit
+
+import (
+	"context"
+	"fmt"
+)
+
+// ElementError reports which element of a slice a Try function failed on. Use errors.As to
+// get the index, or errors.Is / errors.As on the result to inspect the underlying error.
+type ElementError struct {
+	Index int
+	Err   error
+}
+
+func (e *ElementError) Error() string {
+	return fmt.Sprintf("element %d: %v", e.Index, e.Err)
+}
+
+func (e *ElementError) Unwrap() error {
+	return e.Err
+}
+
+// TryMap is Map for a function that can fail. It stops at the first error and returns it
+// wrapped in an *ElementError, with a nil slice.
+//
+//	ids, err := fnkit.TryMap(fields, strconv.Atoi)
+func TryMap[K any, T any](s []K, f func(K) (T, error)) ([]T, error) {
+	return TryMapCtx(context.Background(), s, func(_ context.Context, v K) (T, error) { return f(v) })
+}
+
+// TryMapCtx is TryMap with a context. It checks ctx before each element and stops with
+// ctx.Err(), wrapped in an *ElementError for the element it did not start, once ctx is done.
+func TryMapCtx[K any, T any](ctx context.Context, s []K, f func(context.Context, K) (T, error)) ([]T, error) {
+	result := make([]T, len(s))
+	for i, v := range s {
+		if err := ctx.Err(); err != nil {
+			return nil, &ElementError{Index: i, Err: err}
+		}
+		t, err := f(ctx, v)
+		if err != nil {
+			return nil, &ElementError{Index: i, Err: err}
+		}
+		result[i] = t
+	}
+	return result, nil
+}
+
+// TryFilter is ToFilter for a predicate that can fail. It returns a new slice of the
+// elements for which 'f' returns true, or the first error wrapped in an *ElementError.
+func TryFilter[K any](s []K, f func(K) (bool, error)) ([]K, error) {
+	return TryFilterCtx(context.Background(), s, func(_ context.Context, v K) (bool, error) { return f(v) })
+}
+
+// TryFilterCtx is TryFilter with a context, checked before each element.
+func TryFilterCtx[K any](ctx context.Context, s []K, f func(context.Context, K) (bool, error)) ([]K, error) {
+	var result []K
+	for i, v := range s {
+		if err := ctx.Err(); err != nil {
+			return nil, &ElementError{Index: i, Err: err}
+		}
+		keep, err := f(ctx, v)
+		if err != nil {
+			return nil, &ElementError{Index: i, Err: err}
+		}
+		if keep {
+			result = append(result, v)
+		}
+	}
+	return result, nil
+}
+
+// TryReduce is Reduce for a function that can fail. On error it returns the zero value and
+// the error wrapped in an *ElementError.
+func TryReduce[K any, T any](s []K, initial T, f func(T, K) (T, error)) (T, error) {
+	return TryReduceCtx(context.Background(), s, initial, func(_ context.Context, acc T, v K) (T, error) { return f(acc, v) })
+}
+
+// TryReduceCtx is TryReduce with a context, checked before each element.
+func TryReduceCtx[K any, T any](ctx context.Context, s []K, initial T, f func(context.Context, T, K) (T, error)) (T, error) {
+	accumulator := initial
+	for i, v := range s {
+		var err error
+		if err = ctx.Err(); err == nil {
+			accumulator, err = f(ctx, accumulator, v)
+		}
+		if err != nil {
+			var zero T
+			return zero, &ElementError{Index: i, Err: err}
+		}
+	}
+	return accumulator, nil
+}
+
+// TryForEach is ForEach for a function that can fail. It stops at the first error and
+// returns it wrapped in an *ElementError.
+func TryForEach[K any](s []K, f func(K) error) error {
+	return TryForEachCtx(context.Background(), s, func(_ context.Context, v K) error { return f(v) })
+}
+
+// TryForEachCtx is TryForEach with a context, checked before each element.
+func TryForEachCtx[K any](ctx context.Context, s []K, f func(context.Context, K) error) error {
+	for i, v := range s {
+		err := ctx.Err()
+		if err == nil {
+			err = f(ctx, v)
+		}
+		if err != nil {
+			return &ElementError{Index: i, Err: err}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+package fnkit
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestTryMap(t *testing.T) {
+	got, err := TryMap([]string{"1", "2", "3"}, strconv.Atoi)
+	if err != nil || !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Fatalf("TryMap = %v, %v", got, err)
+	}
+
+	calls := 0
+	got, err = TryMap([]string{"1", "x", "3"}, func(s string) (int, error) {
+		calls++
+		return strconv.Atoi(s)
+	})
+	if got != nil || calls != 2 {
+		t.Errorf("TryMap after error = %v with %d calls, want nil after 2", got, calls)
+	}
+	var elemErr *ElementError
+	if !errors.As(err, &elemErr) || elemErr.Index != 1 {
+		t.Fatalf("TryMap error = %v, want *ElementError at index 1", err)
+	}
+	if !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("TryMap error does not wrap strconv.ErrSyntax: %v", err)
+	}
+	if want := `element 1: strconv.Atoi: parsing "x": invalid syntax`; err.Error() != want {
+		t.Errorf("Error() = %q, want %q", err.Error(), want)
+	}
+
+	r := ResultOf(TryMap([]string{"4"}, strconv.Atoi))
+	if !r.IsOk() || r.Value[0] != 4 {
+		t.Errorf("ResultOf(TryMap) = %+v", r)
+	}
+}
+
+func TestTryFilterReduceForEach(t *testing.T) {
+	errOdd := errors.New("odd")
+	evens, err := TryFilter([]int{2, 4, 5, 6}, func(i int) (bool, error) {
+		if i == 5 {
+			return false, errOdd
+		}
+		return i > 2, nil
+	})
+	if evens != nil || !errors.Is(err, errOdd) {
+		t.Errorf("TryFilter = %v, %v", evens, err)
+	}
+	big, err := TryFilter([]int{1, 5, 9}, func(i int) (bool, error) { return i > 4, nil })
+	if err != nil || !reflect.DeepEqual(big, []int{5, 9}) {
+		t.Errorf("TryFilter = %v, %v", big, err)
+	}
+
+	sum, err := TryReduce([]string{"1", "2", "3"}, 0, func(acc int, s string) (int, error) {
+		n, err := strconv.Atoi(s)
+		return acc + n, err
+	})
+	if err != nil || sum != 6 {
+		t.Errorf("TryReduce = %d, %v", sum, err)
+	}
+	sum, err = TryReduce([]string{"1", "?"}, 0, func(acc int, s string) (int, error) {
+		n, err := strconv.Atoi(s)
+		return acc + n, err
+	})
+	var elemErr *ElementError
+	if sum != 0 || !errors.As(err, &elemErr) || elemErr.Index != 1 {
+		t.Errorf("TryReduce failure = %d, %v", sum, err)
+	}
+
+	var seen []int
+	err = TryForEach([]int{1, 2, 3}, func(i int) error {
+		seen = append(seen, i)
+		if i == 2 {
+			return errOdd
+		}
+		return nil
+	})
+	if !reflect.DeepEqual(seen, []int{1, 2}) || !errors.As(err, &elemErr) || elemErr.Index != 1 {
+		t.Errorf("TryForEach = %v, %v", seen, err)
+	}
+}
+
+func TestTryCtxCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	_, err := TryMapCtx(ctx, []int{1, 2, 3, 4}, func(_ context.Context, i int) (int, error) {
+		calls++
+		if i == 2 {
+			cancel()
+		}
+		return i, nil
+	})
+	var elemErr *ElementError
+	if !errors.Is(err, context.Canceled) || !errors.As(err, &elemErr) || elemErr.Index != 2 || calls != 2 {
+		t.Errorf("TryMapCtx = %v after %d calls, want Canceled at index 2 after 2 calls", err, calls)
+	}
+
+	if _, err := TryFilterCtx(ctx, []int{1}, func(context.Context, int) (bool, error) { return true, nil }); !errors.Is(err, context.Canceled) {
+		t.Errorf("TryFilterCtx on cancelled ctx = %v", err)
+	}
+	if _, err := TryReduceCtx(ctx, []int{1}, 0, func(_ context.Context, a, b int) (int, error) { return a + b, nil }); !errors.Is(err, context.Canceled) {
+		t.Errorf("TryReduceCtx on cancelled ctx = %v", err)
+	}
+	if err := TryForEachCtx(ctx, []int{1}, func(context.Context, int) error { return nil }); !errors.Is(err, context.Canceled) {
+		t.Errorf("TryForEachCtx on cancelled ctx = %v", err)
+	}
+	if err := TryForEachCtx(ctx, []int{}, func(context.Context, int) error { return nil }); err != nil {
+		t.Errorf("TryForEachCtx on empty slice = %v", err)
+	}
+}
@@ -1,3 +1,5 @@
 module github.com/kishankumarhs/fnkit/validations
 
 go 1.23
+
+require golang.org/x/text v0.22.0
@@ -0,0 +1,339 @@
+package validations
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Detector finds one kind of sensitive value in free text.
+type Detector struct {
+	// Name labels the kind of value, e.g. "email"; MaskHash includes it in the output.
+	Name string
+	// Pattern finds candidates. If it has a capturing group, only the first group is
+	// redacted, so "Bearer abc" can keep the "Bearer" prefix.
+	Pattern *regexp.Regexp
+	// Valid optionally confirms a candidate, e.g. with a checksum, to cut false positives.
+	Valid func(string) bool
+}
+
+// Built-in detectors. They reuse the validators in this package where one exists.
+var (
+	EmailDetector = Detector{
+		Name:    "email",
+		Pattern: regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`),
+		Valid:   IsEmail,
+	}
+	CreditCardDetector = Detector{
+		Name:    "card",
+		Pattern: regexp.MustCompile(`\b(?:\d[ -]?){12,18}\d\b`),
+		Valid:   IsCreditCard,
+	}
+	IBANDetector = Detector{
+		Name:    "iban",
+		Pattern: regexp.MustCompile(`\b[A-Z]{2}\d{2}(?: ?[A-Z0-9]){11,30}\b`),
+		Valid:   IsIBAN,
+	}
+	BearerTokenDetector = Detector{
+		Name:    "token",
+		Pattern: regexp.MustCompile(`(?i)\bbearer\s+([A-Za-z0-9\-._~+/]+=*)`),
+	}
+	AWSAccessKeyDetector = Detector{
+		Name:    "aws_key",
+		Pattern: regexp.MustCompile(`\b((?:AKIA|ASIA|AIDA|AROA|AGPA|ANPA|ANVA|AIPA)[A-Z0-9]{16})\b`),
+	}
+	AWSSecretKeyDetector = Detector{
+		Name:    "aws_secret",
+		Pattern: regexp.MustCompile(`(?i)aws_?secret_?access_?key["']?\s*[:=]\s*["']?([A-Za-z0-9/+=]{40})`),
+	}
+	// PhoneDetector only accepts phone-shaped numbers: a leading "+" or "(area)", or digit
+	// groups split by separators in the usual places ("555-123-4567", "020 7946 0958").
+	// Dates, times, dotted IP addresses and bare runs of digits such as IDs are not matched.
+	PhoneDetector = Detector{
+		Name: "phone",
+		Pattern: regexp.MustCompile(`\B\+\d{1,3}(?:[ .\-]?\(\d{1,4}\))?(?:[ .\-]?\d{1,4}){2,5}\b` +
+			`|\B\(\d{2,4}\)[ .\-]?\d{3,4}[ .\-]\d{3,4}\b` +
+			`|\b\d{3}[ .\-]\d{3}[ .\-]\d{4}\b` +
+			`|\b0\d{1,4}[ \-]\d{3,4}[ \-]?\d{3,4}\b`),
+		Valid: func(s string) bool {
+			n := len(nonDigits.ReplaceAllString(s, ""))
+			return n >= 7 && n <= 15
+		},
+	}
+)
+
+var nonDigits = regexp.MustCompile(`\D`)
+
+// confirm checks the candidate s[start:end] with d.Valid. A greedy pattern can take in
+// neighbouring groups, as in "4111 1111 1111 1111 12 items", so when the candidate fails
+// the check its sub-spans on group boundaries that the pattern still matches whole are
+// tried too, longest and then leftmost first, and the first valid one is returned.
+func (d Detector) confirm(s string, start, end int) (int, int, bool) {
+	if d.Valid == nil || d.Valid(s[start:end]) {
+		return start, end, true
+	}
+	isWord := func(i int) bool { return i >= start && i < end && isAlnum(s[i]) }
+	var starts, ends []int
+	for i := start; i <= end; i++ {
+		if isWord(i) && !isWord(i-1) {
+			starts = append(starts, i)
+		}
+		if isWord(i-1) && !isWord(i) {
+			ends = append(ends, i)
+		}
+	}
+	type sub struct{ start, end int }
+	var subs []sub
+	for _, st := range starts {
+		for _, en := range ends {
+			if st < en && en-st < end-start {
+				subs = append(subs, sub{st, en})
+			}
+		}
+	}
+	sort.SliceStable(subs, func(i, j int) bool {
+		return subs[i].end-subs[i].start > subs[j].end-subs[j].start
+	})
+	for _, c := range subs {
+		text := s[c.start:c.end]
+		if loc := d.Pattern.FindStringIndex(text); loc != nil && loc[0] == 0 && loc[1] == len(text) && d.Valid(text) {
+			return c.start, c.end, true
+		}
+	}
+	return start, end, false
+}
+
+func isAlnum(c byte) bool {
+	return c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+// DefaultDetectors are used by Redact and by a Redactor with no detectors. Earlier detectors
+// win when matches overlap, so card numbers and IBANs are not reported as phone numbers.
+var DefaultDetectors = []Detector{
+	AWSSecretKeyDetector,
+	AWSAccessKeyDetector,
+	BearerTokenDetector,
+	EmailDetector,
+	CreditCardDetector,
+	IBANDetector,
+	PhoneDetector,
+}
+
+// MaskStrategy selects how a Redactor replaces sensitive values.
+type MaskStrategy int
+
+const (
+	// MaskFull replaces the value with "[REDACTED]".
+	MaskFull MaskStrategy = iota
+	// MaskPartial keeps the last four letters or digits ("************1111") and, for email
+	// addresses, the first letter and the domain ("j***@example.com").
+	MaskPartial
+	// MaskHash replaces the value with a short, stable digest such as "[email:9f86d081884c]",
+	// so equal values can still be correlated. Set Redactor.HashKey to use a keyed HMAC and
+	// keep low-entropy values such as phone numbers from being brute-forced.
+	MaskHash
+)
+
+// Redactor removes personal data and secrets from strings and structs.
+type Redactor struct {
+	Detectors []Detector // defaults to DefaultDetectors when empty
+	Strategy  MaskStrategy
+	HashKey   []byte // optional HMAC key for MaskHash
+}
+
+var defaultRedactor = &Redactor{}
+
+// Redact replaces every value found by DefaultDetectors in s with "[REDACTED]".
+func Redact(s string) string {
+	return defaultRedactor.Redact(s)
+}
+
+// RedactStruct redacts v in place with DefaultDetectors and MaskFull; see Redactor.RedactStruct.
+func RedactStruct(v any) error {
+	return defaultRedactor.RedactStruct(v)
+}
+
+// Redact replaces every sensitive value the detectors find in s according to the strategy.
+// Overlapping matches are resolved in favour of the earlier detector, then the earlier match.
+func (r *Redactor) Redact(s string) string {
+	type span struct {
+		start, end int
+		name       string
+	}
+	var spans []span
+	overlaps := func(start, end int) bool {
+		for _, sp := range spans {
+			if start < sp.end && sp.start < end {
+				return true
+			}
+		}
+		return false
+	}
+	for _, d := range r.detectors() {
+		for _, loc := range d.Pattern.FindAllStringSubmatchIndex(s, -1) {
+			start, end := loc[0], loc[1]
+			if len(loc) >= 4 && loc[2] >= 0 {
+				start, end = loc[2], loc[3]
+			}
+			start, end, ok := d.confirm(s, start, end)
+			if !ok || overlaps(start, end) {
+				continue
+			}
+			spans = append(spans, span{start, end, d.Name})
+		}
+	}
+	if len(spans) == 0 {
+		return s
+	}
+	sort.Slice(spans, func(i, j int) bool { return spans[i].start < spans[j].start })
+	var b strings.Builder
+	last := 0
+	for _, sp := range spans {
+		b.WriteString(s[last:sp.start])
+		b.WriteString(r.mask(sp.name, s[sp.start:sp.end]))
+		last = sp.end
+	}
+	b.WriteString(s[last:])
+	return b.String()
+}
+
+// RedactStruct redacts the struct pointed to by v in place, walking nested structs,
+// pointers, slices, arrays, maps and interfaces. Fields tagged `redact:"true"` are masked
+// as a whole: every string in them according to the strategy, other scalars reset to zero.
+// All other strings are scanned with the detectors, and fields tagged `redact:"-"` are
+// left alone. Unexported fields are skipped. Redact a copy if the original is still needed.
+func (r *Redactor) RedactStruct(v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return fmt.Errorf("validations: RedactStruct expects a non-nil pointer, got %T", v)
+	}
+	r.redactValue(rv.Elem(), false, make(map[redactVisit]bool))
+	return nil
+}
+
+// redactVisit identifies a pointer, map or slice already redacted, so that cyclic and
+// shared data is walked only once for each masking mode.
+type redactVisit struct {
+	ptr   uintptr
+	typ   reflect.Type
+	whole bool
+}
+
+// redactValue redacts the settable value v. With whole set, strings are masked entirely
+// instead of scanned, and numbers, booleans and other scalars are reset to zero.
+func (r *Redactor) redactValue(v reflect.Value, whole bool, seen map[redactVisit]bool) {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Map, reflect.Slice:
+		if v.IsNil() {
+			return
+		}
+		key := redactVisit{v.Pointer(), v.Type(), whole}
+		if seen[key] {
+			return
+		}
+		seen[key] = true
+	}
+	switch v.Kind() {
+	case reflect.String:
+		if whole {
+			v.SetString(r.mask("redacted", v.String()))
+		} else {
+			v.SetString(r.Redact(v.String()))
+		}
+	case reflect.Pointer:
+		if !v.IsNil() {
+			r.redactValue(v.Elem(), whole, seen)
+		}
+	case reflect.Interface:
+		if v.IsNil() {
+			return
+		}
+		// Values held in an interface are not addressable: redact a copy and store it back.
+		elem := reflect.New(v.Elem().Type()).Elem()
+		elem.Set(v.Elem())
+		r.redactValue(elem, whole, seen)
+		v.Set(elem)
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if !f.IsExported() {
+				continue
+			}
+			if tag := f.Tag.Get("redact"); tag != "-" {
+				r.redactValue(v.Field(i), whole || tag == "true", seen)
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			r.redactValue(v.Index(i), whole, seen)
+		}
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			elem := reflect.New(iter.Value().Type()).Elem()
+			elem.Set(iter.Value())
+			r.redactValue(elem, whole, seen)
+			v.SetMapIndex(iter.Key(), elem)
+		}
+	default:
+		if whole {
+			v.SetZero()
+		}
+	}
+}
+
+func (r *Redactor) detectors() []Detector {
+	if len(r.Detectors) == 0 {
+		return DefaultDetectors
+	}
+	return r.Detectors
+}
+
+func (r *Redactor) mask(name, value string) string {
+	switch r.Strategy {
+	case MaskPartial:
+		return maskPartial(value)
+	case MaskHash:
+		var sum []byte
+		if len(r.HashKey) > 0 {
+			h := hmac.New(sha256.New, r.HashKey)
+			h.Write([]byte(value))
+			sum = h.Sum(nil)
+		} else {
+			s := sha256.Sum256([]byte(value))
+			sum = s[:]
+		}
+		return "[" + name + ":" + hex.EncodeToString(sum[:6]) + "]"
+	default:
+		return "[REDACTED]"
+	}
+}
+
+// maskPartial hides all but the last four letters or digits of value, keeping separators.
+// Email addresses keep their first letter and domain instead.
+func maskPartial(value string) string {
+	if local, domain, ok := strings.Cut(value, "@"); ok && local != "" {
+		first := []rune(local)[0]
+		return string(first) + "***@" + domain
+	}
+	runes := []rune(value)
+	keep := 4
+	for i := len(runes) - 1; i >= 0; i-- {
+		if !unicode.IsLetter(runes[i]) && !unicode.IsDigit(runes[i]) {
+			continue
+		}
+		if keep > 0 {
+			keep--
+			continue
+		}
+		runes[i] = '*'
+	}
+	return string(runes)
+}
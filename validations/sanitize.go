@@ -0,0 +1,172 @@
+package validations
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Sanitizer transforms a string into a normalized form.
+type Sanitizer func(string) string
+
+// sanitizers maps the rule names accepted in `sanitize` struct tags to their implementation.
+var sanitizers = map[string]Sanitizer{
+	"trim":     strings.TrimSpace,
+	"collapse": CollapseSpace,
+	"lower":    strings.ToLower,
+	"upper":    strings.ToUpper,
+	"nfc":      NormalizeNFC,
+	"nfkc":     NormalizeNFKC,
+	"email":    NormalizeEmail,
+	"phone":    NormalizePhone,
+	"card":     NormalizeCreditCard,
+}
+
+// Sanitize applies each sanitizer to s in order and returns the result.
+func Sanitize(s string, fns ...Sanitizer) string {
+	for _, f := range fns {
+		s = f(s)
+	}
+	return s
+}
+
+// CollapseSpace trims s and replaces every run of Unicode whitespace with a single space.
+func CollapseSpace(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// NormalizeNFC returns s in Unicode Normalization Form C (canonical composition).
+func NormalizeNFC(s string) string {
+	return norm.NFC.String(s)
+}
+
+// NormalizeNFKC returns s in Unicode Normalization Form KC (compatibility composition),
+// folding full-width letters, ligatures and similar variants to their plain forms.
+func NormalizeNFKC(s string) string {
+	return norm.NFKC.String(s)
+}
+
+// NormalizeEmail trims, NFKC-normalizes and lowercases an email address.
+func NormalizeEmail(s string) string {
+	return strings.ToLower(strings.TrimSpace(NormalizeNFKC(s)))
+}
+
+// NormalizePhone strips formatting (spaces, dashes, dots, parentheses) from a phone number,
+// keeping the digits and a leading '+' if present.
+func NormalizePhone(s string) string {
+	s = strings.TrimSpace(NormalizeNFKC(s))
+	var b strings.Builder
+	for i, r := range s {
+		if unicode.IsDigit(r) || (r == '+' && i == 0) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// NormalizeCreditCard strips everything but digits from a card number.
+func NormalizeCreditCard(s string) string {
+	s = NormalizeNFKC(s)
+	var b strings.Builder
+	for _, r := range s {
+		if '0' <= r && r <= '9' {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// SanitizeStruct applies the rules listed in `sanitize` struct tags to the string fields of
+// the struct pointed to by v, in place. Rules are comma-separated and applied left to right:
+//
+//	type Signup struct {
+//		Email string `sanitize:"email"`
+//		Name  string `sanitize:"trim,collapse,nfc"`
+//	}
+//
+// Supported rules are trim, collapse, lower, upper, nfc, nfkc, email, phone and card.
+// Tagged fields may be string, *string or []string; nested structs and struct pointers are
+// walked recursively. Call it before running validators so they see the normalized values.
+func SanitizeStruct(v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("validations: SanitizeStruct expects a non-nil pointer to a struct, got %T", v)
+	}
+	return sanitizeStruct(rv.Elem())
+}
+
+func sanitizeStruct(rv reflect.Value) error {
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		fv := rv.Field(i)
+		tag := field.Tag.Get("sanitize")
+		if tag == "" || tag == "-" {
+			if err := sanitizeNested(fv); err != nil {
+				return err
+			}
+			continue
+		}
+		fns, err := parseSanitizeTag(tag)
+		if err != nil {
+			return fmt.Errorf("validations: field %s: %w", field.Name, err)
+		}
+		if err := applySanitizers(fv, fns); err != nil {
+			return fmt.Errorf("validations: field %s: %w", field.Name, err)
+		}
+	}
+	return nil
+}
+
+// sanitizeNested descends into untagged struct and struct-pointer fields.
+func sanitizeNested(fv reflect.Value) error {
+	switch fv.Kind() {
+	case reflect.Struct:
+		return sanitizeStruct(fv)
+	case reflect.Pointer:
+		if !fv.IsNil() && fv.Elem().Kind() == reflect.Struct {
+			return sanitizeStruct(fv.Elem())
+		}
+	}
+	return nil
+}
+
+func parseSanitizeTag(tag string) ([]Sanitizer, error) {
+	var fns []Sanitizer
+	for _, name := range strings.Split(tag, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		f, ok := sanitizers[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown sanitize rule %q", name)
+		}
+		fns = append(fns, f)
+	}
+	return fns, nil
+}
+
+func applySanitizers(fv reflect.Value, fns []Sanitizer) error {
+	switch {
+	case fv.Kind() == reflect.String:
+		fv.SetString(Sanitize(fv.String(), fns...))
+	case fv.Kind() == reflect.Pointer && fv.Type().Elem().Kind() == reflect.String:
+		if !fv.IsNil() {
+			fv.Elem().SetString(Sanitize(fv.Elem().String(), fns...))
+		}
+	case fv.Kind() == reflect.Slice && fv.Type().Elem().Kind() == reflect.String:
+		for j := 0; j < fv.Len(); j++ {
+			fv.Index(j).SetString(Sanitize(fv.Index(j).String(), fns...))
+		}
+	default:
+		return fmt.Errorf("sanitize tag on unsupported type %s", fv.Type())
+	}
+	return nil
+}
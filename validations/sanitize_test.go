@@ -0,0 +1,102 @@
+package validations_test
+
+import (
+	"testing"
+
+	"github.com/kishankumarhs/fnkit/validations"
+)
+
+func TestCollapseSpace(t *testing.T) {
+	if got := validations.CollapseSpace("  hello \t\n  world  "); got != "hello world" {
+		t.Errorf("CollapseSpace() = %q", got)
+	}
+}
+
+func TestNormalizeNFCAndNFKC(t *testing.T) {
+	// "e" + combining acute accent composes to a single "é".
+	if got := validations.NormalizeNFC("é"); got != "é" {
+		t.Errorf("NormalizeNFC() = %q", got)
+	}
+	// Full-width letters and the "fi" ligature fold to ASCII under NFKC.
+	if got := validations.NormalizeNFKC("Ａﬁ"); got != "Afi" {
+		t.Errorf("NormalizeNFKC() = %q", got)
+	}
+}
+
+func TestNormalizeEmail(t *testing.T) {
+	if got := validations.NormalizeEmail("  Foo.Bar@Example.COM "); got != "foo.bar@example.com" {
+		t.Errorf("NormalizeEmail() = %q", got)
+	}
+}
+
+func TestNormalizePhoneAndCard(t *testing.T) {
+	if got := validations.NormalizePhone(" +1 (800) 555-12.34 "); got != "+18005551234" {
+		t.Errorf("NormalizePhone() = %q", got)
+	}
+	if got := validations.NormalizeCreditCard("4111-1111 1111 1111"); got != "4111111111111111" {
+		t.Errorf("NormalizeCreditCard() = %q", got)
+	}
+}
+
+func TestSanitizeStruct(t *testing.T) {
+	type address struct {
+		City string `sanitize:"trim,collapse"`
+	}
+	type signup struct {
+		Email   string   `sanitize:"email"`
+		Name    *string  `sanitize:"trim,collapse,nfc"`
+		Tags    []string `sanitize:"trim,lower"`
+		Phone   string   `sanitize:"phone"`
+		Raw     string
+		Address *address
+	}
+	name := "  José   Díaz "
+	in := signup{
+		Email:   " Jose@Example.com",
+		Name:    &name,
+		Tags:    []string{" VIP ", "New"},
+		Phone:   "+44 20 7946 0958",
+		Raw:     "  untouched ",
+		Address: &address{City: "  New   York "},
+	}
+	if err := validations.SanitizeStruct(&in); err != nil {
+		t.Fatalf("SanitizeStruct() error = %v", err)
+	}
+	if in.Email != "jose@example.com" || !validations.IsEmail(in.Email) {
+		t.Errorf("Email = %q", in.Email)
+	}
+	if *in.Name != "José Díaz" {
+		t.Errorf("Name = %q", *in.Name)
+	}
+	if in.Tags[0] != "vip" || in.Tags[1] != "new" {
+		t.Errorf("Tags = %q", in.Tags)
+	}
+	if in.Phone != "+442079460958" {
+		t.Errorf("Phone = %q", in.Phone)
+	}
+	if in.Raw != "  untouched " {
+		t.Errorf("Raw = %q", in.Raw)
+	}
+	if in.Address.City != "New York" {
+		t.Errorf("Address.City = %q", in.Address.City)
+	}
+}
+
+func TestSanitizeStructErrors(t *testing.T) {
+	var s struct{ Name string }
+	if err := validations.SanitizeStruct(s); err == nil {
+		t.Error("expected error for non-pointer")
+	}
+	bad := struct {
+		Name string `sanitize:"shout"`
+	}{}
+	if err := validations.SanitizeStruct(&bad); err == nil {
+		t.Error("expected error for unknown rule")
+	}
+	wrong := struct {
+		Age int `sanitize:"trim"`
+	}{}
+	if err := validations.SanitizeStruct(&wrong); err == nil {
+		t.Error("expected error for non-string field")
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"net/mail"
 	"net/url"
 	"regexp"
+	"strings"
 	"unicode"
 )
 
@@ -147,3 +148,34 @@ var uuidRegex = regexp.MustCompile(`^[a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[1-5][a-fA-F0
 func IsUUID(s string) bool {
 	return uuidRegex.MatchString(s)
 }
+
+// IsIBAN returns true if s is a valid International Bank Account Number (ISO 13616,
+// mod-97 check). Spaces are ignored and letters may be lowercase.
+func IsIBAN(s string) bool {
+	s = strings.ToUpper(strings.ReplaceAll(s, " ", ""))
+	if len(s) < 15 || len(s) > 34 {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case i < 2 && !('A' <= c && c <= 'Z'):
+			return false
+		case i >= 2 && i < 4 && !('0' <= c && c <= '9'):
+			return false
+		case !('A' <= c && c <= 'Z') && !('0' <= c && c <= '9'):
+			return false
+		}
+	}
+	rearranged := s[4:] + s[:4]
+	rem := 0
+	for i := 0; i < len(rearranged); i++ {
+		c := rearranged[i]
+		if c >= 'A' {
+			rem = (rem*100 + int(c-'A'+10)) % 97
+		} else {
+			rem = (rem*10 + int(c-'0')) % 97
+		}
+	}
+	return rem == 1
+}